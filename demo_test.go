@@ -25,6 +25,16 @@ func (m *replicatorClientMock) Sync(ctx context.Context, in *SyncRequest, opts .
 	return args.Get(0).(*SyncResponse), args.Error(1)
 }
 
+func (m *replicatorClientMock) Rights(ctx context.Context, in *RightsRequest, opts ...grpc.CallOption) (*RightsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*RightsResponse), args.Error(1)
+}
+
+func (m *replicatorClientMock) Fetch(ctx context.Context, in *FetchRequest, opts ...grpc.CallOption) (*FetchResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*FetchResponse), args.Error(1)
+}
+
 func TestEmptySyncRequestIfEmptyVariables(t *testing.T) {
 
 	mockClient := &replicatorClientMock{}
@@ -0,0 +1,102 @@
+package rplx
+
+import (
+	"container/heap"
+)
+
+// expiryItem describe variable scheduled for expiration
+type expiryItem struct {
+	name  string
+	ttl   int64
+	index int
+}
+
+// expiryQueue is min-heap of variables ordered by TTL
+// implements heap.Interface, use set/remove/popExpired methods instead of direct heap calls
+type expiryQueue struct {
+	items []*expiryItem
+	names map[string]*expiryItem
+}
+
+func newExpiryQueue() *expiryQueue {
+	return &expiryQueue{
+		names: make(map[string]*expiryItem),
+	}
+}
+
+func (q *expiryQueue) Len() int {
+	return len(q.items)
+}
+
+func (q *expiryQueue) Less(i, j int) bool {
+	return q.items[i].ttl < q.items[j].ttl
+}
+
+func (q *expiryQueue) Swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+	q.items[i].index = i
+	q.items[j].index = j
+}
+
+func (q *expiryQueue) Push(x interface{}) {
+	item := x.(*expiryItem)
+	item.index = len(q.items)
+	q.items = append(q.items, item)
+	q.names[item.name] = item
+}
+
+func (q *expiryQueue) Pop() interface{} {
+	n := len(q.items)
+	item := q.items[n-1]
+	q.items[n-1] = nil
+	q.items = q.items[:n-1]
+	delete(q.names, item.name)
+	item.index = -1
+	return item
+}
+
+// set schedules variable expiration or moves already scheduled variable
+// returns true, if variable became the first in the queue
+func (q *expiryQueue) set(name string, ttl int64) bool {
+	item, ok := q.names[name]
+	if ok {
+		item.ttl = ttl
+		heap.Fix(q, item.index)
+	} else {
+		item = &expiryItem{name: name, ttl: ttl}
+		heap.Push(q, item)
+	}
+
+	return item.index == 0
+}
+
+// remove removes variable from the queue, if exists
+func (q *expiryQueue) remove(name string) {
+	item, ok := q.names[name]
+	if !ok {
+		return
+	}
+
+	heap.Remove(q, item.index)
+}
+
+// next returns the nearest TTL in the queue, second param is false if queue is empty
+func (q *expiryQueue) next() (int64, bool) {
+	if len(q.items) == 0 {
+		return 0, false
+	}
+
+	return q.items[0].ttl, true
+}
+
+// popExpired removes from the queue and returns names of variables with TTL less than now
+func (q *expiryQueue) popExpired(now int64) []string {
+	names := make([]string, 0)
+
+	for len(q.items) > 0 && q.items[0].ttl < now {
+		item := heap.Pop(q).(*expiryItem)
+		names = append(names, item.name)
+	}
+
+	return names
+}
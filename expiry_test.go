@@ -0,0 +1,98 @@
+package rplx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestExpiryQueue_Order(t *testing.T) {
+	q := newExpiryQueue()
+
+	assert.True(t, q.set("A", 300))
+	assert.True(t, q.set("B", 100))
+	assert.False(t, q.set("C", 200))
+
+	ttl, ok := q.next()
+	require.True(t, ok)
+	assert.Equal(t, int64(100), ttl)
+
+	assert.Equal(t, []string{"B", "C"}, q.popExpired(250))
+	assert.Equal(t, 1, q.Len())
+}
+
+func TestExpiryQueue_SetExists(t *testing.T) {
+	q := newExpiryQueue()
+
+	q.set("A", 100)
+	q.set("B", 200)
+	assert.True(t, q.set("B", 50))
+	q.set("A", 500)
+
+	assert.Equal(t, []string{"B"}, q.popExpired(400))
+	assert.Equal(t, []string{"A"}, q.popExpired(600))
+
+	_, ok := q.next()
+	assert.False(t, ok)
+}
+
+func TestExpiryQueue_Remove(t *testing.T) {
+	q := newExpiryQueue()
+
+	q.set("A", 100)
+	q.set("B", 200)
+	q.remove("A")
+	q.remove("NOT-EXISTS")
+
+	assert.Equal(t, []string{"B"}, q.popExpired(300))
+	assert.Len(t, q.names, 0)
+}
+
+func TestGC_RemovesOnlyExpiredVariables(t *testing.T) {
+	r := New()
+
+	r.Upsert("VAR-1", 1)
+	r.Upsert("VAR-2", 2)
+	r.Upsert("VAR-3", 3)
+
+	require.NoError(t, r.UpdateTTL("VAR-1", time.Now().UTC().Add(-time.Second)))
+	require.NoError(t, r.UpdateTTL("VAR-2", time.Now().UTC().Add(time.Hour)))
+
+	r.gc()
+
+	r.variablesMx.RLock()
+	defer r.variablesMx.RUnlock()
+
+	assert.Len(t, r.variables, 2)
+	assert.NotContains(t, r.variables, "VAR-1")
+}
+
+func TestGC_RemovesVariableCloseToTTL(t *testing.T) {
+	r := New()
+
+	r.Upsert("VAR-1", 1)
+	require.NoError(t, r.UpdateTTL("VAR-1", time.Now().UTC().Add(time.Millisecond*50)))
+
+	time.Sleep(time.Millisecond * 200)
+
+	r.variablesMx.RLock()
+	defer r.variablesMx.RUnlock()
+
+	assert.NotContains(t, r.variables, "VAR-1")
+}
+
+func TestGC_SkipsVariableWithUpdatedTTL(t *testing.T) {
+	r := New()
+
+	r.Upsert("VAR-1", 1)
+	require.NoError(t, r.UpdateTTL("VAR-1", time.Now().UTC().Add(time.Millisecond*50)))
+	require.NoError(t, r.UpdateTTL("VAR-1", time.Now().UTC().Add(time.Hour)))
+
+	time.Sleep(time.Millisecond * 200)
+
+	r.variablesMx.RLock()
+	defer r.variablesMx.RUnlock()
+
+	assert.Contains(t, r.variables, "VAR-1")
+}
@@ -3,13 +3,14 @@
 
 package rplx
 
-import proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
-
 import (
-	context "golang.org/x/net/context"
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -21,9 +22,389 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Kind int32
+
+const (
+	Kind_Counter         Kind = 0
+	Kind_Window          Kind = 1
+	Kind_Period          Kind = 2
+	Kind_Register        Kind = 3
+	Kind_Set             Kind = 4
+	Kind_Max             Kind = 5
+	Kind_Min             Kind = 6
+	Kind_Float           Kind = 7
+	Kind_Bounded         Kind = 8
+	Kind_HyperLogLog     Kind = 9
+	Kind_Histogram       Kind = 10
+	Kind_CountMin        Kind = 11
+	Kind_Hash            Kind = 12
+	Kind_SortedSet       Kind = 13
+	Kind_Average         Kind = 14
+	Kind_Decay           Kind = 15
+	Kind_EnableWinsFlag  Kind = 16
+	Kind_DisableWinsFlag Kind = 17
+	Kind_Custom          Kind = 18
+	Kind_Sequence        Kind = 19
+)
+
+var Kind_name = map[int32]string{
+	0:  "Counter",
+	1:  "Window",
+	2:  "Period",
+	3:  "Register",
+	4:  "Set",
+	5:  "Max",
+	6:  "Min",
+	7:  "Float",
+	8:  "Bounded",
+	9:  "HyperLogLog",
+	10: "Histogram",
+	11: "CountMin",
+	12: "Hash",
+	13: "SortedSet",
+	14: "Average",
+	15: "Decay",
+	16: "EnableWinsFlag",
+	17: "DisableWinsFlag",
+	18: "Custom",
+	19: "Sequence",
+}
+
+var Kind_value = map[string]int32{
+	"Counter":         0,
+	"Window":          1,
+	"Period":          2,
+	"Register":        3,
+	"Set":             4,
+	"Max":             5,
+	"Min":             6,
+	"Float":           7,
+	"Bounded":         8,
+	"HyperLogLog":     9,
+	"Histogram":       10,
+	"CountMin":        11,
+	"Hash":            12,
+	"SortedSet":       13,
+	"Average":         14,
+	"Decay":           15,
+	"EnableWinsFlag":  16,
+	"DisableWinsFlag": 17,
+	"Custom":          18,
+	"Sequence":        19,
+}
+
+func (x Kind) String() string {
+	return proto.EnumName(Kind_name, int32(x))
+}
+
+func (Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{0}
+}
+
+type PeriodLength int32
+
+const (
+	PeriodLength_Hourly  PeriodLength = 0
+	PeriodLength_Daily   PeriodLength = 1
+	PeriodLength_Monthly PeriodLength = 2
+)
+
+var PeriodLength_name = map[int32]string{
+	0: "Hourly",
+	1: "Daily",
+	2: "Monthly",
+}
+
+var PeriodLength_value = map[string]int32{
+	"Hourly":  0,
+	"Daily":   1,
+	"Monthly": 2,
+}
+
+func (x PeriodLength) String() string {
+	return proto.EnumName(PeriodLength_name, int32(x))
+}
+
+func (PeriodLength) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{1}
+}
 
 type SyncNodeValue struct {
+	// Counter kind - node value
+	// Max and Min kinds - the greatest or the least value, written on node
+	// Average kind - sum of samples, added on node
+	Value   int64 `protobuf:"varint,1,opt,name=Value,proto3" json:"Value,omitempty"`
+	Version int64 `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	// Window kind, map key - bucket index
+	// Period kind, map key - period start time in unix seconds
+	Buckets map[int64]int64 `protobuf:"bytes,3,rep,name=Buckets,proto3" json:"Buckets,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Register kind
+	Register *SyncRegister `protobuf:"bytes,4,opt,name=Register,proto3" json:"Register,omitempty"`
+	// Set, EnableWinsFlag and DisableWinsFlag kinds
+	Set *SyncSet `protobuf:"bytes,5,opt,name=Set,proto3" json:"Set,omitempty"`
+	// Max and Min kinds - Value is written on node, part without value is skipped
+	HasValue bool `protobuf:"varint,6,opt,name=HasValue,proto3" json:"HasValue,omitempty"`
+	// Float kind - node value
+	// Decay kind - node value at Timestamp
+	Float float64 `protobuf:"fixed64,7,opt,name=Float,proto3" json:"Float,omitempty"`
+	// Counter kind - high 64 bits of node value, used by OverflowBig policy
+	ValueHigh int64 `protobuf:"varint,8,opt,name=ValueHigh,proto3" json:"ValueHigh,omitempty"`
+	// Bounded kind
+	Bounded *SyncBounded `protobuf:"bytes,9,opt,name=Bounded,proto3" json:"Bounded,omitempty"`
+	// HyperLogLog kind
+	HyperLogLog *SyncHyperLogLog `protobuf:"bytes,10,opt,name=HyperLogLog,proto3" json:"HyperLogLog,omitempty"`
+	// Histogram kind
+	Histogram *SyncHistogram `protobuf:"bytes,11,opt,name=Histogram,proto3" json:"Histogram,omitempty"`
+	// CountMin kind
+	CountMin *SyncCountMin `protobuf:"bytes,12,opt,name=CountMin,proto3" json:"CountMin,omitempty"`
+	// Hash and SortedSet kinds
+	Hash *SyncHash `protobuf:"bytes,13,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	// Hash and Custom kinds - value contains changes after Since version, 0 for full state
+	// changes are not applied by node, which has not applied Since version
+	Since int64 `protobuf:"varint,14,opt,name=Since,proto3" json:"Since,omitempty"`
+	// Average kind - count of samples, added on node
+	Count int64 `protobuf:"varint,15,opt,name=Count,proto3" json:"Count,omitempty"`
+	// Decay kind - reference time of node value in unix nanoseconds
+	Timestamp int64 `protobuf:"varint,16,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	// Custom kind - opaque encoded changes of node state
+	Payload []byte `protobuf:"bytes,17,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	// Custom kind - Payload contains full node state, state is replaced
+	Full bool `protobuf:"varint,18,opt,name=Full,proto3" json:"Full,omitempty"`
+	// Sequence kind
+	Sequence             *SyncSequence `protobuf:"bytes,19,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SyncNodeValue) Reset()         { *m = SyncNodeValue{} }
+func (m *SyncNodeValue) String() string { return proto.CompactTextString(m) }
+func (*SyncNodeValue) ProtoMessage()    {}
+func (*SyncNodeValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{0}
+}
+
+func (m *SyncNodeValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncNodeValue.Unmarshal(m, b)
+}
+func (m *SyncNodeValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncNodeValue.Marshal(b, m, deterministic)
+}
+func (m *SyncNodeValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncNodeValue.Merge(m, src)
+}
+func (m *SyncNodeValue) XXX_Size() int {
+	return xxx_messageInfo_SyncNodeValue.Size(m)
+}
+func (m *SyncNodeValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncNodeValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncNodeValue proto.InternalMessageInfo
+
+func (m *SyncNodeValue) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *SyncNodeValue) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SyncNodeValue) GetBuckets() map[int64]int64 {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *SyncNodeValue) GetRegister() *SyncRegister {
+	if m != nil {
+		return m.Register
+	}
+	return nil
+}
+
+func (m *SyncNodeValue) GetSet() *SyncSet {
+	if m != nil {
+		return m.Set
+	}
+	return nil
+}
+
+func (m *SyncNodeValue) GetHasValue() bool {
+	if m != nil {
+		return m.HasValue
+	}
+	return false
+}
+
+func (m *SyncNodeValue) GetFloat() float64 {
+	if m != nil {
+		return m.Float
+	}
+	return 0
+}
+
+func (m *SyncNodeValue) GetValueHigh() int64 {
+	if m != nil {
+		return m.ValueHigh
+	}
+	return 0
+}
+
+func (m *SyncNodeValue) GetBounded() *SyncBounded {
+	if m != nil {
+		return m.Bounded
+	}
+	return nil
+}
+
+func (m *SyncNodeValue) GetHyperLogLog() *SyncHyperLogLog {
+	if m != nil {
+		return m.HyperLogLog
+	}
+	return nil
+}
+
+func (m *SyncNodeValue) GetHistogram() *SyncHistogram {
+	if m != nil {
+		return m.Histogram
+	}
+	return nil
+}
+
+func (m *SyncNodeValue) GetCountMin() *SyncCountMin {
+	if m != nil {
+		return m.CountMin
+	}
+	return nil
+}
+
+func (m *SyncNodeValue) GetHash() *SyncHash {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *SyncNodeValue) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *SyncNodeValue) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *SyncNodeValue) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *SyncNodeValue) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *SyncNodeValue) GetFull() bool {
+	if m != nil {
+		return m.Full
+	}
+	return false
+}
+
+func (m *SyncNodeValue) GetSequence() *SyncSequence {
+	if m != nil {
+		return m.Sequence
+	}
+	return nil
+}
+
+type SyncSequence struct {
+	// reserved slot, valid if Claimed is not zero
+	Slot int32 `protobuf:"varint,1,opt,name=Slot,proto3" json:"Slot,omitempty"`
+	// slot claim time in unix nano, the earliest claim of slot wins
+	Claimed int64 `protobuf:"varint,2,opt,name=Claimed,proto3" json:"Claimed,omitempty"`
+	// claim expiration time in unix nano, expired slot may be claimed by other node
+	Expire int64 `protobuf:"varint,3,opt,name=Expire,proto3" json:"Expire,omitempty"`
+	// the last millisecond, used by node for IDs of the slot
+	LastMs               int64    `protobuf:"varint,4,opt,name=LastMs,proto3" json:"LastMs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncSequence) Reset()         { *m = SyncSequence{} }
+func (m *SyncSequence) String() string { return proto.CompactTextString(m) }
+func (*SyncSequence) ProtoMessage()    {}
+func (*SyncSequence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{1}
+}
+
+func (m *SyncSequence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncSequence.Unmarshal(m, b)
+}
+func (m *SyncSequence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncSequence.Marshal(b, m, deterministic)
+}
+func (m *SyncSequence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncSequence.Merge(m, src)
+}
+func (m *SyncSequence) XXX_Size() int {
+	return xxx_messageInfo_SyncSequence.Size(m)
+}
+func (m *SyncSequence) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncSequence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncSequence proto.InternalMessageInfo
+
+func (m *SyncSequence) GetSlot() int32 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *SyncSequence) GetClaimed() int64 {
+	if m != nil {
+		return m.Claimed
+	}
+	return 0
+}
+
+func (m *SyncSequence) GetExpire() int64 {
+	if m != nil {
+		return m.Expire
+	}
+	return 0
+}
+
+func (m *SyncSequence) GetLastMs() int64 {
+	if m != nil {
+		return m.LastMs
+	}
+	return 0
+}
+
+type SyncHashField struct {
 	Value                int64    `protobuf:"varint,1,opt,name=Value,proto3" json:"Value,omitempty"`
 	Version              int64    `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -31,68 +412,687 @@ type SyncNodeValue struct {
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SyncNodeValue) Reset()         { *m = SyncNodeValue{} }
-func (m *SyncNodeValue) String() string { return proto.CompactTextString(m) }
-func (*SyncNodeValue) ProtoMessage()    {}
-func (*SyncNodeValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_e443170929639c88, []int{0}
+func (m *SyncHashField) Reset()         { *m = SyncHashField{} }
+func (m *SyncHashField) String() string { return proto.CompactTextString(m) }
+func (*SyncHashField) ProtoMessage()    {}
+func (*SyncHashField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{2}
+}
+
+func (m *SyncHashField) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncHashField.Unmarshal(m, b)
+}
+func (m *SyncHashField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncHashField.Marshal(b, m, deterministic)
+}
+func (m *SyncHashField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncHashField.Merge(m, src)
+}
+func (m *SyncHashField) XXX_Size() int {
+	return xxx_messageInfo_SyncHashField.Size(m)
+}
+func (m *SyncHashField) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncHashField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncHashField proto.InternalMessageInfo
+
+func (m *SyncHashField) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *SyncHashField) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type SyncHash struct {
+	// fields, changed after the last replicated version, map key - field name
+	Fields map[string]*SyncHashField `protobuf:"bytes,1,rep,name=Fields,proto3" json:"Fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// version of the last part reset, fields with less version are removed
+	ResetVersion         int64    `protobuf:"varint,2,opt,name=ResetVersion,proto3" json:"ResetVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncHash) Reset()         { *m = SyncHash{} }
+func (m *SyncHash) String() string { return proto.CompactTextString(m) }
+func (*SyncHash) ProtoMessage()    {}
+func (*SyncHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{3}
+}
+
+func (m *SyncHash) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncHash.Unmarshal(m, b)
+}
+func (m *SyncHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncHash.Marshal(b, m, deterministic)
+}
+func (m *SyncHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncHash.Merge(m, src)
+}
+func (m *SyncHash) XXX_Size() int {
+	return xxx_messageInfo_SyncHash.Size(m)
+}
+func (m *SyncHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncHash proto.InternalMessageInfo
+
+func (m *SyncHash) GetFields() map[string]*SyncHashField {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *SyncHash) GetResetVersion() int64 {
+	if m != nil {
+		return m.ResetVersion
+	}
+	return 0
+}
+
+type SyncCountMin struct {
+	// not zero sketch cells, map key - row * width + column
+	Cells map[uint32]int64 `protobuf:"bytes,1,rep,name=Cells,proto3" json:"Cells,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// heavy hitters candidates of node, map value - node estimate
+	Candidates           map[string]int64 `protobuf:"bytes,2,rep,name=Candidates,proto3" json:"Candidates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SyncCountMin) Reset()         { *m = SyncCountMin{} }
+func (m *SyncCountMin) String() string { return proto.CompactTextString(m) }
+func (*SyncCountMin) ProtoMessage()    {}
+func (*SyncCountMin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{4}
+}
+
+func (m *SyncCountMin) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncCountMin.Unmarshal(m, b)
+}
+func (m *SyncCountMin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncCountMin.Marshal(b, m, deterministic)
+}
+func (m *SyncCountMin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncCountMin.Merge(m, src)
+}
+func (m *SyncCountMin) XXX_Size() int {
+	return xxx_messageInfo_SyncCountMin.Size(m)
+}
+func (m *SyncCountMin) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncCountMin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncCountMin proto.InternalMessageInfo
+
+func (m *SyncCountMin) GetCells() map[uint32]int64 {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+func (m *SyncCountMin) GetCandidates() map[string]int64 {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+type SyncHistogram struct {
+	// map key - log bucket index, map value - count of observations
+	Buckets map[int32]int64 `protobuf:"bytes,1,rep,name=Buckets,proto3" json:"Buckets,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// count of not positive observations
+	Zero                 int64    `protobuf:"varint,2,opt,name=Zero,proto3" json:"Zero,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncHistogram) Reset()         { *m = SyncHistogram{} }
+func (m *SyncHistogram) String() string { return proto.CompactTextString(m) }
+func (*SyncHistogram) ProtoMessage()    {}
+func (*SyncHistogram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{5}
+}
+
+func (m *SyncHistogram) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncHistogram.Unmarshal(m, b)
+}
+func (m *SyncHistogram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncHistogram.Marshal(b, m, deterministic)
+}
+func (m *SyncHistogram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncHistogram.Merge(m, src)
+}
+func (m *SyncHistogram) XXX_Size() int {
+	return xxx_messageInfo_SyncHistogram.Size(m)
+}
+func (m *SyncHistogram) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncHistogram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncHistogram proto.InternalMessageInfo
+
+func (m *SyncHistogram) GetBuckets() map[int32]int64 {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *SyncHistogram) GetZero() int64 {
+	if m != nil {
+		return m.Zero
+	}
+	return 0
+}
+
+type SyncHyperLogLog struct {
+	// dense registers, empty for sparse encoding
+	Registers []byte `protobuf:"bytes,1,opt,name=Registers,proto3" json:"Registers,omitempty"`
+	// sparse encoding, indexes and values of not zero registers
+	SparseIndexes        []uint32 `protobuf:"varint,2,rep,packed,name=SparseIndexes,proto3" json:"SparseIndexes,omitempty"`
+	SparseValues         []byte   `protobuf:"bytes,3,opt,name=SparseValues,proto3" json:"SparseValues,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncHyperLogLog) Reset()         { *m = SyncHyperLogLog{} }
+func (m *SyncHyperLogLog) String() string { return proto.CompactTextString(m) }
+func (*SyncHyperLogLog) ProtoMessage()    {}
+func (*SyncHyperLogLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{6}
+}
+
+func (m *SyncHyperLogLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncHyperLogLog.Unmarshal(m, b)
+}
+func (m *SyncHyperLogLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncHyperLogLog.Marshal(b, m, deterministic)
+}
+func (m *SyncHyperLogLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncHyperLogLog.Merge(m, src)
+}
+func (m *SyncHyperLogLog) XXX_Size() int {
+	return xxx_messageInfo_SyncHyperLogLog.Size(m)
+}
+func (m *SyncHyperLogLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncHyperLogLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncHyperLogLog proto.InternalMessageInfo
+
+func (m *SyncHyperLogLog) GetRegisters() []byte {
+	if m != nil {
+		return m.Registers
+	}
+	return nil
+}
+
+func (m *SyncHyperLogLog) GetSparseIndexes() []uint32 {
+	if m != nil {
+		return m.SparseIndexes
+	}
+	return nil
+}
+
+func (m *SyncHyperLogLog) GetSparseValues() []byte {
+	if m != nil {
+		return m.SparseValues
+	}
+	return nil
+}
+
+type SyncBounded struct {
+	Increments int64 `protobuf:"varint,1,opt,name=Increments,proto3" json:"Increments,omitempty"`
+	Decrements int64 `protobuf:"varint,2,opt,name=Decrements,proto3" json:"Decrements,omitempty"`
+	// rights, transferred by node to other nodes, map key - node ID
+	Transfers            map[string]int64 `protobuf:"bytes,3,rep,name=Transfers,proto3" json:"Transfers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SyncBounded) Reset()         { *m = SyncBounded{} }
+func (m *SyncBounded) String() string { return proto.CompactTextString(m) }
+func (*SyncBounded) ProtoMessage()    {}
+func (*SyncBounded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{7}
+}
+
+func (m *SyncBounded) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncBounded.Unmarshal(m, b)
+}
+func (m *SyncBounded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncBounded.Marshal(b, m, deterministic)
+}
+func (m *SyncBounded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncBounded.Merge(m, src)
+}
+func (m *SyncBounded) XXX_Size() int {
+	return xxx_messageInfo_SyncBounded.Size(m)
+}
+func (m *SyncBounded) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncBounded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncBounded proto.InternalMessageInfo
+
+func (m *SyncBounded) GetIncrements() int64 {
+	if m != nil {
+		return m.Increments
+	}
+	return 0
+}
+
+func (m *SyncBounded) GetDecrements() int64 {
+	if m != nil {
+		return m.Decrements
+	}
+	return 0
+}
+
+func (m *SyncBounded) GetTransfers() map[string]int64 {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+type SyncRegister struct {
+	Value []byte `protobuf:"bytes,1,opt,name=Value,proto3" json:"Value,omitempty"`
+	// write time in unix nano
+	Timestamp            int64    `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncRegister) Reset()         { *m = SyncRegister{} }
+func (m *SyncRegister) String() string { return proto.CompactTextString(m) }
+func (*SyncRegister) ProtoMessage()    {}
+func (*SyncRegister) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{8}
+}
+
+func (m *SyncRegister) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncRegister.Unmarshal(m, b)
+}
+func (m *SyncRegister) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncRegister.Marshal(b, m, deterministic)
+}
+func (m *SyncRegister) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncRegister.Merge(m, src)
+}
+func (m *SyncRegister) XXX_Size() int {
+	return xxx_messageInfo_SyncRegister.Size(m)
+}
+func (m *SyncRegister) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncRegister.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncRegister proto.InternalMessageInfo
+
+func (m *SyncRegister) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *SyncRegister) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type SyncDecay struct {
+	// half-life in nanoseconds
+	HalfLife             int64    `protobuf:"varint,1,opt,name=HalfLife,proto3" json:"HalfLife,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncDecay) Reset()         { *m = SyncDecay{} }
+func (m *SyncDecay) String() string { return proto.CompactTextString(m) }
+func (*SyncDecay) ProtoMessage()    {}
+func (*SyncDecay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{9}
+}
+
+func (m *SyncDecay) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncDecay.Unmarshal(m, b)
+}
+func (m *SyncDecay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncDecay.Marshal(b, m, deterministic)
+}
+func (m *SyncDecay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncDecay.Merge(m, src)
+}
+func (m *SyncDecay) XXX_Size() int {
+	return xxx_messageInfo_SyncDecay.Size(m)
+}
+func (m *SyncDecay) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncDecay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncDecay proto.InternalMessageInfo
+
+func (m *SyncDecay) GetHalfLife() int64 {
+	if m != nil {
+		return m.HalfLife
+	}
+	return 0
+}
+
+type SyncWindow struct {
+	// window size in nanoseconds
+	Size int64 `protobuf:"varint,1,opt,name=Size,proto3" json:"Size,omitempty"`
+	// bucket size in nanoseconds
+	BucketSize           int64    `protobuf:"varint,2,opt,name=BucketSize,proto3" json:"BucketSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncWindow) Reset()         { *m = SyncWindow{} }
+func (m *SyncWindow) String() string { return proto.CompactTextString(m) }
+func (*SyncWindow) ProtoMessage()    {}
+func (*SyncWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{10}
+}
+
+func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncWindow.Unmarshal(m, b)
+}
+func (m *SyncWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncWindow.Marshal(b, m, deterministic)
+}
+func (m *SyncWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindow.Merge(m, src)
+}
+func (m *SyncWindow) XXX_Size() int {
+	return xxx_messageInfo_SyncWindow.Size(m)
+}
+func (m *SyncWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
+
+func (m *SyncWindow) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *SyncWindow) GetBucketSize() int64 {
+	if m != nil {
+		return m.BucketSize
+	}
+	return 0
+}
+
+type SyncPeriod struct {
+	Length PeriodLength `protobuf:"varint,1,opt,name=Length,proto3,enum=rplx.PeriodLength" json:"Length,omitempty"`
+	// zone offset in seconds east of UTC
+	ZoneOffset           int64    `protobuf:"varint,2,opt,name=ZoneOffset,proto3" json:"ZoneOffset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncPeriod) Reset()         { *m = SyncPeriod{} }
+func (m *SyncPeriod) String() string { return proto.CompactTextString(m) }
+func (*SyncPeriod) ProtoMessage()    {}
+func (*SyncPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{11}
+}
+
+func (m *SyncPeriod) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncPeriod.Unmarshal(m, b)
+}
+func (m *SyncPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncPeriod.Marshal(b, m, deterministic)
+}
+func (m *SyncPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncPeriod.Merge(m, src)
+}
+func (m *SyncPeriod) XXX_Size() int {
+	return xxx_messageInfo_SyncPeriod.Size(m)
+}
+func (m *SyncPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncPeriod proto.InternalMessageInfo
+
+func (m *SyncPeriod) GetLength() PeriodLength {
+	if m != nil {
+		return m.Length
+	}
+	return PeriodLength_Hourly
+}
+
+func (m *SyncPeriod) GetZoneOffset() int64 {
+	if m != nil {
+		return m.ZoneOffset
+	}
+	return 0
+}
+
+type SyncSetTag struct {
+	Seq uint64 `protobuf:"varint,1,opt,name=Seq,proto3" json:"Seq,omitempty"`
+	// tag expiration time in unix nano, 0 - without expiration
+	Expire               int64    `protobuf:"varint,2,opt,name=Expire,proto3" json:"Expire,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncSetTag) Reset()         { *m = SyncSetTag{} }
+func (m *SyncSetTag) String() string { return proto.CompactTextString(m) }
+func (*SyncSetTag) ProtoMessage()    {}
+func (*SyncSetTag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{12}
+}
+
+func (m *SyncSetTag) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncSetTag.Unmarshal(m, b)
+}
+func (m *SyncSetTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncSetTag.Marshal(b, m, deterministic)
+}
+func (m *SyncSetTag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncSetTag.Merge(m, src)
+}
+func (m *SyncSetTag) XXX_Size() int {
+	return xxx_messageInfo_SyncSetTag.Size(m)
+}
+func (m *SyncSetTag) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncSetTag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncSetTag proto.InternalMessageInfo
+
+func (m *SyncSetTag) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *SyncSetTag) GetExpire() int64 {
+	if m != nil {
+		return m.Expire
+	}
+	return 0
+}
+
+type SyncSetRemove struct {
+	// node ID and seq of removed tag
+	NodeID               string   `protobuf:"bytes,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+	Seq                  uint64   `protobuf:"varint,2,opt,name=Seq,proto3" json:"Seq,omitempty"`
+	Expire               int64    `protobuf:"varint,3,opt,name=Expire,proto3" json:"Expire,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncSetRemove) Reset()         { *m = SyncSetRemove{} }
+func (m *SyncSetRemove) String() string { return proto.CompactTextString(m) }
+func (*SyncSetRemove) ProtoMessage()    {}
+func (*SyncSetRemove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{13}
+}
+
+func (m *SyncSetRemove) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncSetRemove.Unmarshal(m, b)
+}
+func (m *SyncSetRemove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncSetRemove.Marshal(b, m, deterministic)
+}
+func (m *SyncSetRemove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncSetRemove.Merge(m, src)
+}
+func (m *SyncSetRemove) XXX_Size() int {
+	return xxx_messageInfo_SyncSetRemove.Size(m)
+}
+func (m *SyncSetRemove) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncSetRemove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncSetRemove proto.InternalMessageInfo
+
+func (m *SyncSetRemove) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
+	}
+	return ""
+}
+
+func (m *SyncSetRemove) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *SyncSetRemove) GetExpire() int64 {
+	if m != nil {
+		return m.Expire
+	}
+	return 0
+}
+
+type SyncSet struct {
+	// map key - set member
+	Adds    map[string]*SyncSetTag `protobuf:"bytes,1,rep,name=Adds,proto3" json:"Adds,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Removes []*SyncSetRemove       `protobuf:"bytes,2,rep,name=Removes,proto3" json:"Removes,omitempty"`
+	// last tag seq, issued by node
+	Seq                  uint64   `protobuf:"varint,3,opt,name=Seq,proto3" json:"Seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncSet) Reset()         { *m = SyncSet{} }
+func (m *SyncSet) String() string { return proto.CompactTextString(m) }
+func (*SyncSet) ProtoMessage()    {}
+func (*SyncSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{14}
 }
-func (m *SyncNodeValue) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SyncNodeValue.Unmarshal(m, b)
+
+func (m *SyncSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncSet.Unmarshal(m, b)
 }
-func (m *SyncNodeValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SyncNodeValue.Marshal(b, m, deterministic)
+func (m *SyncSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncSet.Marshal(b, m, deterministic)
 }
-func (dst *SyncNodeValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SyncNodeValue.Merge(dst, src)
+func (m *SyncSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncSet.Merge(m, src)
 }
-func (m *SyncNodeValue) XXX_Size() int {
-	return xxx_messageInfo_SyncNodeValue.Size(m)
+func (m *SyncSet) XXX_Size() int {
+	return xxx_messageInfo_SyncSet.Size(m)
 }
-func (m *SyncNodeValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_SyncNodeValue.DiscardUnknown(m)
+func (m *SyncSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncSet.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SyncNodeValue proto.InternalMessageInfo
+var xxx_messageInfo_SyncSet proto.InternalMessageInfo
 
-func (m *SyncNodeValue) GetValue() int64 {
+func (m *SyncSet) GetAdds() map[string]*SyncSetTag {
 	if m != nil {
-		return m.Value
+		return m.Adds
 	}
-	return 0
+	return nil
 }
 
-func (m *SyncNodeValue) GetVersion() int64 {
+func (m *SyncSet) GetRemoves() []*SyncSetRemove {
 	if m != nil {
-		return m.Version
+		return m.Removes
+	}
+	return nil
+}
+
+func (m *SyncSet) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
 	}
 	return 0
 }
 
 type SyncVariable struct {
 	// map key - nodeID
-	NodesValues          map[string]*SyncNodeValue `protobuf:"bytes,1,rep,name=NodesValues,proto3" json:"NodesValues,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	TTL                  int64                     `protobuf:"varint,2,opt,name=TTL,proto3" json:"TTL,omitempty"`
-	TTLVersion           int64                     `protobuf:"varint,3,opt,name=TTLVersion,proto3" json:"TTLVersion,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	NodesValues map[string]*SyncNodeValue `protobuf:"bytes,1,rep,name=NodesValues,proto3" json:"NodesValues,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TTL         int64                     `protobuf:"varint,2,opt,name=TTL,proto3" json:"TTL,omitempty"`
+	TTLVersion  int64                     `protobuf:"varint,3,opt,name=TTLVersion,proto3" json:"TTLVersion,omitempty"`
+	Kind        Kind                      `protobuf:"varint,4,opt,name=Kind,proto3,enum=rplx.Kind" json:"Kind,omitempty"`
+	// Window kind options
+	Window *SyncWindow `protobuf:"bytes,5,opt,name=Window,proto3" json:"Window,omitempty"`
+	// Period kind options
+	Period *SyncPeriod `protobuf:"bytes,6,opt,name=Period,proto3" json:"Period,omitempty"`
+	// Counter kind - overflow policy, set by SetOverflowPolicy
+	Overflow *SyncOverflow `protobuf:"bytes,7,opt,name=Overflow,proto3" json:"Overflow,omitempty"`
+	// Decay kind options
+	Decay *SyncDecay `protobuf:"bytes,8,opt,name=Decay,proto3" json:"Decay,omitempty"`
+	// Custom kind - name of registered type
+	CustomType           string   `protobuf:"bytes,9,opt,name=CustomType,proto3" json:"CustomType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SyncVariable) Reset()         { *m = SyncVariable{} }
 func (m *SyncVariable) String() string { return proto.CompactTextString(m) }
 func (*SyncVariable) ProtoMessage()    {}
 func (*SyncVariable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_e443170929639c88, []int{1}
+	return fileDescriptor_33c57e4bae7b9afd, []int{15}
 }
+
 func (m *SyncVariable) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SyncVariable.Unmarshal(m, b)
 }
 func (m *SyncVariable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_SyncVariable.Marshal(b, m, deterministic)
 }
-func (dst *SyncVariable) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SyncVariable.Merge(dst, src)
+func (m *SyncVariable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncVariable.Merge(m, src)
 }
 func (m *SyncVariable) XXX_Size() int {
 	return xxx_messageInfo_SyncVariable.Size(m)
@@ -124,6 +1124,96 @@ func (m *SyncVariable) GetTTLVersion() int64 {
 	return 0
 }
 
+func (m *SyncVariable) GetKind() Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return Kind_Counter
+}
+
+func (m *SyncVariable) GetWindow() *SyncWindow {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+func (m *SyncVariable) GetPeriod() *SyncPeriod {
+	if m != nil {
+		return m.Period
+	}
+	return nil
+}
+
+func (m *SyncVariable) GetOverflow() *SyncOverflow {
+	if m != nil {
+		return m.Overflow
+	}
+	return nil
+}
+
+func (m *SyncVariable) GetDecay() *SyncDecay {
+	if m != nil {
+		return m.Decay
+	}
+	return nil
+}
+
+func (m *SyncVariable) GetCustomType() string {
+	if m != nil {
+		return m.CustomType
+	}
+	return ""
+}
+
+type SyncOverflow struct {
+	Policy int32 `protobuf:"varint,1,opt,name=Policy,proto3" json:"Policy,omitempty"`
+	// the latest policy wins
+	Version              int64    `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncOverflow) Reset()         { *m = SyncOverflow{} }
+func (m *SyncOverflow) String() string { return proto.CompactTextString(m) }
+func (*SyncOverflow) ProtoMessage()    {}
+func (*SyncOverflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{16}
+}
+
+func (m *SyncOverflow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncOverflow.Unmarshal(m, b)
+}
+func (m *SyncOverflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncOverflow.Marshal(b, m, deterministic)
+}
+func (m *SyncOverflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncOverflow.Merge(m, src)
+}
+func (m *SyncOverflow) XXX_Size() int {
+	return xxx_messageInfo_SyncOverflow.Size(m)
+}
+func (m *SyncOverflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncOverflow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncOverflow proto.InternalMessageInfo
+
+func (m *SyncOverflow) GetPolicy() int32 {
+	if m != nil {
+		return m.Policy
+	}
+	return 0
+}
+
+func (m *SyncOverflow) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type SyncRequest struct {
 	NodeID string `protobuf:"bytes,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
 	// map key - variable name
@@ -137,16 +1227,17 @@ func (m *SyncRequest) Reset()         { *m = SyncRequest{} }
 func (m *SyncRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncRequest) ProtoMessage()    {}
 func (*SyncRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_e443170929639c88, []int{2}
+	return fileDescriptor_33c57e4bae7b9afd, []int{17}
 }
+
 func (m *SyncRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SyncRequest.Unmarshal(m, b)
 }
 func (m *SyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_SyncRequest.Marshal(b, m, deterministic)
 }
-func (dst *SyncRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SyncRequest.Merge(dst, src)
+func (m *SyncRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncRequest.Merge(m, src)
 }
 func (m *SyncRequest) XXX_Size() int {
 	return xxx_messageInfo_SyncRequest.Size(m)
@@ -172,7 +1263,10 @@ func (m *SyncRequest) GetVariables() map[string]*SyncVariable {
 }
 
 type SyncResponse struct {
-	Code                 int64    `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Code int64 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	// keys <VARIABLE_NAME>@<NODE_ID> of changes, which base version is not applied on node,
+	// sender replicates full state of these parts with the next sync
+	Resync               []string `protobuf:"bytes,2,rep,name=Resync,proto3" json:"Resync,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -182,16 +1276,17 @@ func (m *SyncResponse) Reset()         { *m = SyncResponse{} }
 func (m *SyncResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncResponse) ProtoMessage()    {}
 func (*SyncResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_e443170929639c88, []int{3}
+	return fileDescriptor_33c57e4bae7b9afd, []int{18}
 }
+
 func (m *SyncResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SyncResponse.Unmarshal(m, b)
 }
 func (m *SyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_SyncResponse.Marshal(b, m, deterministic)
 }
-func (dst *SyncResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SyncResponse.Merge(dst, src)
+func (m *SyncResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncResponse.Merge(m, src)
 }
 func (m *SyncResponse) XXX_Size() int {
 	return xxx_messageInfo_SyncResponse.Size(m)
@@ -209,6 +1304,221 @@ func (m *SyncResponse) GetCode() int64 {
 	return 0
 }
 
+func (m *SyncResponse) GetResync() []string {
+	if m != nil {
+		return m.Resync
+	}
+	return nil
+}
+
+type RightsRequest struct {
+	NodeID string `protobuf:"bytes,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+	// variable name
+	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RightsRequest) Reset()         { *m = RightsRequest{} }
+func (m *RightsRequest) String() string { return proto.CompactTextString(m) }
+func (*RightsRequest) ProtoMessage()    {}
+func (*RightsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{19}
+}
+
+func (m *RightsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RightsRequest.Unmarshal(m, b)
+}
+func (m *RightsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RightsRequest.Marshal(b, m, deterministic)
+}
+func (m *RightsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RightsRequest.Merge(m, src)
+}
+func (m *RightsRequest) XXX_Size() int {
+	return xxx_messageInfo_RightsRequest.Size(m)
+}
+func (m *RightsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RightsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RightsRequest proto.InternalMessageInfo
+
+func (m *RightsRequest) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
+	}
+	return ""
+}
+
+func (m *RightsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RightsRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type RightsResponse struct {
+	Code    int64 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Granted int64 `protobuf:"varint,2,opt,name=Granted,proto3" json:"Granted,omitempty"`
+	// part of node, granted rights
+	Value                *SyncNodeValue `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RightsResponse) Reset()         { *m = RightsResponse{} }
+func (m *RightsResponse) String() string { return proto.CompactTextString(m) }
+func (*RightsResponse) ProtoMessage()    {}
+func (*RightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{20}
+}
+
+func (m *RightsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RightsResponse.Unmarshal(m, b)
+}
+func (m *RightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RightsResponse.Marshal(b, m, deterministic)
+}
+func (m *RightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RightsResponse.Merge(m, src)
+}
+func (m *RightsResponse) XXX_Size() int {
+	return xxx_messageInfo_RightsResponse.Size(m)
+}
+func (m *RightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RightsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RightsResponse proto.InternalMessageInfo
+
+func (m *RightsResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *RightsResponse) GetGranted() int64 {
+	if m != nil {
+		return m.Granted
+	}
+	return 0
+}
+
+func (m *RightsResponse) GetValue() *SyncNodeValue {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type FetchRequest struct {
+	NodeID string `protobuf:"bytes,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+	// variable name
+	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FetchRequest) Reset()         { *m = FetchRequest{} }
+func (m *FetchRequest) String() string { return proto.CompactTextString(m) }
+func (*FetchRequest) ProtoMessage()    {}
+func (*FetchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{21}
+}
+
+func (m *FetchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FetchRequest.Unmarshal(m, b)
+}
+func (m *FetchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FetchRequest.Marshal(b, m, deterministic)
+}
+func (m *FetchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchRequest.Merge(m, src)
+}
+func (m *FetchRequest) XXX_Size() int {
+	return xxx_messageInfo_FetchRequest.Size(m)
+}
+func (m *FetchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchRequest proto.InternalMessageInfo
+
+func (m *FetchRequest) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
+	}
+	return ""
+}
+
+func (m *FetchRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type FetchResponse struct {
+	Code int64 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	// variable with values of all nodes, known on node
+	Variable             *SyncVariable `protobuf:"bytes,2,opt,name=Variable,proto3" json:"Variable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *FetchResponse) Reset()         { *m = FetchResponse{} }
+func (m *FetchResponse) String() string { return proto.CompactTextString(m) }
+func (*FetchResponse) ProtoMessage()    {}
+func (*FetchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{22}
+}
+
+func (m *FetchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FetchResponse.Unmarshal(m, b)
+}
+func (m *FetchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FetchResponse.Marshal(b, m, deterministic)
+}
+func (m *FetchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchResponse.Merge(m, src)
+}
+func (m *FetchResponse) XXX_Size() int {
+	return xxx_messageInfo_FetchResponse.Size(m)
+}
+func (m *FetchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchResponse proto.InternalMessageInfo
+
+func (m *FetchResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *FetchResponse) GetVariable() *SyncVariable {
+	if m != nil {
+		return m.Variable
+	}
+	return nil
+}
+
 type HelloRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -219,16 +1529,17 @@ func (m *HelloRequest) Reset()         { *m = HelloRequest{} }
 func (m *HelloRequest) String() string { return proto.CompactTextString(m) }
 func (*HelloRequest) ProtoMessage()    {}
 func (*HelloRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_e443170929639c88, []int{4}
+	return fileDescriptor_33c57e4bae7b9afd, []int{23}
 }
+
 func (m *HelloRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HelloRequest.Unmarshal(m, b)
 }
 func (m *HelloRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_HelloRequest.Marshal(b, m, deterministic)
 }
-func (dst *HelloRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HelloRequest.Merge(dst, src)
+func (m *HelloRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelloRequest.Merge(m, src)
 }
 func (m *HelloRequest) XXX_Size() int {
 	return xxx_messageInfo_HelloRequest.Size(m)
@@ -250,16 +1561,17 @@ func (m *HelloResponse) Reset()         { *m = HelloResponse{} }
 func (m *HelloResponse) String() string { return proto.CompactTextString(m) }
 func (*HelloResponse) ProtoMessage()    {}
 func (*HelloResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_e443170929639c88, []int{5}
+	return fileDescriptor_33c57e4bae7b9afd, []int{24}
 }
+
 func (m *HelloResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HelloResponse.Unmarshal(m, b)
 }
 func (m *HelloResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_HelloResponse.Marshal(b, m, deterministic)
 }
-func (dst *HelloResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HelloResponse.Merge(dst, src)
+func (m *HelloResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelloResponse.Merge(m, src)
 }
 func (m *HelloResponse) XXX_Size() int {
 	return xxx_messageInfo_HelloResponse.Size(m)
@@ -278,16 +1590,149 @@ func (m *HelloResponse) GetID() string {
 }
 
 func init() {
+	proto.RegisterEnum("rplx.Kind", Kind_name, Kind_value)
+	proto.RegisterEnum("rplx.PeriodLength", PeriodLength_name, PeriodLength_value)
 	proto.RegisterType((*SyncNodeValue)(nil), "rplx.SyncNodeValue")
+	proto.RegisterMapType((map[int64]int64)(nil), "rplx.SyncNodeValue.BucketsEntry")
+	proto.RegisterType((*SyncSequence)(nil), "rplx.SyncSequence")
+	proto.RegisterType((*SyncHashField)(nil), "rplx.SyncHashField")
+	proto.RegisterType((*SyncHash)(nil), "rplx.SyncHash")
+	proto.RegisterMapType((map[string]*SyncHashField)(nil), "rplx.SyncHash.FieldsEntry")
+	proto.RegisterType((*SyncCountMin)(nil), "rplx.SyncCountMin")
+	proto.RegisterMapType((map[string]int64)(nil), "rplx.SyncCountMin.CandidatesEntry")
+	proto.RegisterMapType((map[uint32]int64)(nil), "rplx.SyncCountMin.CellsEntry")
+	proto.RegisterType((*SyncHistogram)(nil), "rplx.SyncHistogram")
+	proto.RegisterMapType((map[int32]int64)(nil), "rplx.SyncHistogram.BucketsEntry")
+	proto.RegisterType((*SyncHyperLogLog)(nil), "rplx.SyncHyperLogLog")
+	proto.RegisterType((*SyncBounded)(nil), "rplx.SyncBounded")
+	proto.RegisterMapType((map[string]int64)(nil), "rplx.SyncBounded.TransfersEntry")
+	proto.RegisterType((*SyncRegister)(nil), "rplx.SyncRegister")
+	proto.RegisterType((*SyncDecay)(nil), "rplx.SyncDecay")
+	proto.RegisterType((*SyncWindow)(nil), "rplx.SyncWindow")
+	proto.RegisterType((*SyncPeriod)(nil), "rplx.SyncPeriod")
+	proto.RegisterType((*SyncSetTag)(nil), "rplx.SyncSetTag")
+	proto.RegisterType((*SyncSetRemove)(nil), "rplx.SyncSetRemove")
+	proto.RegisterType((*SyncSet)(nil), "rplx.SyncSet")
+	proto.RegisterMapType((map[string]*SyncSetTag)(nil), "rplx.SyncSet.AddsEntry")
 	proto.RegisterType((*SyncVariable)(nil), "rplx.SyncVariable")
 	proto.RegisterMapType((map[string]*SyncNodeValue)(nil), "rplx.SyncVariable.NodesValuesEntry")
+	proto.RegisterType((*SyncOverflow)(nil), "rplx.SyncOverflow")
 	proto.RegisterType((*SyncRequest)(nil), "rplx.SyncRequest")
 	proto.RegisterMapType((map[string]*SyncVariable)(nil), "rplx.SyncRequest.VariablesEntry")
 	proto.RegisterType((*SyncResponse)(nil), "rplx.SyncResponse")
+	proto.RegisterType((*RightsRequest)(nil), "rplx.RightsRequest")
+	proto.RegisterType((*RightsResponse)(nil), "rplx.RightsResponse")
+	proto.RegisterType((*FetchRequest)(nil), "rplx.FetchRequest")
+	proto.RegisterType((*FetchResponse)(nil), "rplx.FetchResponse")
 	proto.RegisterType((*HelloRequest)(nil), "rplx.HelloRequest")
 	proto.RegisterType((*HelloResponse)(nil), "rplx.HelloResponse")
 }
 
+func init() { proto.RegisterFile("message.proto", fileDescriptor_33c57e4bae7b9afd) }
+
+var fileDescriptor_33c57e4bae7b9afd = []byte{
+	// 1578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x18, 0xcb, 0x6e, 0xdb, 0xc6,
+	0x36, 0xd4, 0x5b, 0x47, 0x0f, 0xd3, 0xe3, 0xdc, 0x5c, 0x82, 0xb8, 0x37, 0x11, 0xd8, 0x97, 0x9a,
+	0xa0, 0x2a, 0xaa, 0xa0, 0x6d, 0x60, 0xb4, 0x69, 0xe2, 0x57, 0x6d, 0xd4, 0x4e, 0xdc, 0x91, 0x90,
+	0x14, 0xd9, 0x4d, 0xc4, 0xb1, 0x44, 0x84, 0x22, 0x15, 0x0e, 0xe5, 0x58, 0x5d, 0xf5, 0x0b, 0xfa,
+	0x03, 0xfd, 0x8b, 0x6e, 0xba, 0xef, 0xa6, 0x8b, 0xfe, 0x42, 0x7f, 0xa1, 0xff, 0x50, 0xcc, 0x8b,
+	0x1c, 0x2a, 0x8e, 0x83, 0x74, 0xa5, 0x39, 0xcf, 0x39, 0xef, 0x39, 0x14, 0x74, 0xe6, 0x94, 0x31,
+	0x32, 0xa5, 0x83, 0x45, 0x12, 0xa7, 0x31, 0xaa, 0x24, 0x8b, 0xf0, 0xc2, 0xfb, 0xbb, 0x0a, 0x9d,
+	0xd1, 0x2a, 0x9a, 0x3c, 0x8a, 0x7d, 0xfa, 0x84, 0x84, 0x4b, 0x8a, 0xae, 0x43, 0x55, 0x1c, 0x1c,
+	0xab, 0x67, 0xf5, 0xcb, 0x58, 0x02, 0xc8, 0x81, 0xfa, 0x13, 0x9a, 0xb0, 0x20, 0x8e, 0x9c, 0x92,
+	0xc0, 0x6b, 0x10, 0x6d, 0x43, 0x7d, 0x67, 0x39, 0x79, 0x41, 0x53, 0xe6, 0x94, 0x7b, 0xe5, 0x7e,
+	0x6b, 0xd8, 0x1b, 0x70, 0xcd, 0x83, 0x82, 0xd6, 0x81, 0x62, 0xd9, 0x8f, 0xd2, 0x64, 0x85, 0xb5,
+	0x00, 0x1a, 0x40, 0x03, 0xd3, 0x69, 0xc0, 0x52, 0x9a, 0x38, 0x95, 0x9e, 0xd5, 0x6f, 0x0d, 0x51,
+	0x2e, 0xac, 0x29, 0x38, 0xe3, 0x41, 0xb7, 0xa0, 0x3c, 0xa2, 0xa9, 0x53, 0x15, 0xac, 0x9d, 0x9c,
+	0x75, 0x44, 0x53, 0xcc, 0x29, 0xc8, 0x85, 0xc6, 0x21, 0x61, 0xd2, 0xfe, 0x5a, 0xcf, 0xea, 0x37,
+	0x70, 0x06, 0x73, 0xc7, 0x0e, 0xc2, 0x98, 0xa4, 0x4e, 0xbd, 0x67, 0xf5, 0x2d, 0x2c, 0x01, 0xf4,
+	0x3f, 0x68, 0x0a, 0xf2, 0x61, 0x30, 0x9d, 0x39, 0x0d, 0xe1, 0x5a, 0x8e, 0x40, 0x77, 0xa0, 0xbe,
+	0x13, 0x2f, 0x23, 0x9f, 0xfa, 0x4e, 0x53, 0x5c, 0xba, 0x99, 0x5f, 0xaa, 0x08, 0x58, 0x73, 0xa0,
+	0x2f, 0xa1, 0x75, 0xb8, 0x5a, 0xd0, 0xe4, 0x38, 0x9e, 0x1e, 0xc7, 0x53, 0x07, 0x84, 0xc0, 0x7f,
+	0x72, 0x01, 0x83, 0x88, 0x4d, 0x4e, 0xf4, 0x19, 0x34, 0x0f, 0x03, 0x96, 0xc6, 0xd3, 0x84, 0xcc,
+	0x9d, 0x96, 0x10, 0xdb, 0x32, 0xc4, 0x34, 0x09, 0xe7, 0x5c, 0x3c, 0x72, 0xbb, 0xf1, 0x32, 0x4a,
+	0x4f, 0x82, 0xc8, 0x69, 0xaf, 0x47, 0x4e, 0x53, 0x70, 0xc6, 0x83, 0x3c, 0xa8, 0x1c, 0x12, 0x36,
+	0x73, 0x3a, 0x82, 0xb7, 0x6b, 0x68, 0x27, 0x6c, 0x86, 0x05, 0x8d, 0x07, 0x68, 0x14, 0x44, 0x13,
+	0xea, 0x74, 0x65, 0xe6, 0x05, 0xc0, 0xb1, 0x42, 0x8b, 0xb3, 0x21, 0xb1, 0x02, 0xe0, 0x61, 0x1b,
+	0x07, 0x73, 0xca, 0x52, 0x32, 0x5f, 0x38, 0xb6, 0x0c, 0x5b, 0x86, 0xe0, 0xd5, 0x72, 0x4a, 0x56,
+	0x61, 0x4c, 0x7c, 0x67, 0xb3, 0x67, 0xf5, 0xdb, 0x58, 0x83, 0x08, 0x41, 0xe5, 0x60, 0x19, 0x86,
+	0x0e, 0x12, 0xc9, 0x11, 0x67, 0xee, 0xcb, 0x88, 0xbe, 0x5c, 0x52, 0x7e, 0xf5, 0xd6, 0xba, 0x2f,
+	0x9a, 0x82, 0x33, 0x1e, 0x77, 0x1b, 0xda, 0x66, 0x39, 0x21, 0x1b, 0xca, 0x2f, 0xe8, 0x4a, 0xd5,
+	0x2b, 0x3f, 0x72, 0x9b, 0xcf, 0x45, 0x0d, 0xc8, 0x5a, 0x95, 0xc0, 0x76, 0xe9, 0x9e, 0xe5, 0x85,
+	0xd0, 0x36, 0xb5, 0x72, 0x7b, 0x46, 0x61, 0x9c, 0x0a, 0xe1, 0x2a, 0x16, 0x67, 0x6e, 0xfd, 0x6e,
+	0x48, 0x82, 0x39, 0xf5, 0x75, 0xad, 0x2b, 0x10, 0xdd, 0x80, 0xda, 0xfe, 0xc5, 0x22, 0x48, 0xa8,
+	0x53, 0x16, 0x04, 0x05, 0x71, 0xfc, 0x31, 0x61, 0xe9, 0x09, 0x13, 0x55, 0x5c, 0xc6, 0x0a, 0xf2,
+	0xbe, 0x91, 0xcd, 0xc5, 0xa3, 0x7b, 0x10, 0xd0, 0xd0, 0x7f, 0xd7, 0xe6, 0xf2, 0x7e, 0xb5, 0xa0,
+	0xa1, 0x35, 0xa0, 0x21, 0xd4, 0x84, 0x16, 0xe6, 0x58, 0xa2, 0xd1, 0xdc, 0x62, 0x16, 0x07, 0x92,
+	0x28, 0x5b, 0x4c, 0x71, 0x22, 0x0f, 0xda, 0x98, 0x32, 0x9a, 0x16, 0xf5, 0x17, 0x70, 0xee, 0x23,
+	0x68, 0x19, 0xa2, 0x66, 0x38, 0x9b, 0x32, 0x9c, 0x1f, 0x9b, 0xe1, 0x2c, 0xd6, 0xa6, 0xf6, 0xcc,
+	0x8c, 0xf1, 0x4f, 0x25, 0x19, 0xe4, 0xac, 0xf8, 0xee, 0x42, 0x75, 0x97, 0x86, 0xa1, 0xb6, 0xfb,
+	0xff, 0xaf, 0x57, 0xea, 0x40, 0xd0, 0xa5, 0xe9, 0x92, 0x17, 0xed, 0x00, 0xec, 0x92, 0xc8, 0x0f,
+	0x7c, 0x92, 0x52, 0xe6, 0x94, 0x84, 0xa4, 0x77, 0x99, 0x64, 0xc6, 0x24, 0xc5, 0x0d, 0x29, 0xf7,
+	0x1e, 0x40, 0xae, 0xd8, 0x74, 0xac, 0xf3, 0x96, 0x3a, 0x71, 0xbf, 0x86, 0x8d, 0x35, 0xc5, 0x97,
+	0xc4, 0xe5, 0xcd, 0x65, 0xf6, 0x8b, 0xa5, 0x32, 0x9f, 0x35, 0xac, 0x31, 0x26, 0xad, 0xf5, 0x31,
+	0x99, 0x71, 0xbd, 0x61, 0x4c, 0x22, 0xa8, 0x3c, 0xa3, 0x49, 0xac, 0xae, 0x11, 0xe7, 0xab, 0x9a,
+	0xa0, 0xfa, 0x36, 0xeb, 0x56, 0xb0, 0xb1, 0x36, 0x8f, 0x78, 0x3f, 0xeb, 0x29, 0xcb, 0x84, 0x92,
+	0x36, 0xce, 0x11, 0xe8, 0x7d, 0xe8, 0x8c, 0x16, 0x24, 0x61, 0xf4, 0x28, 0xf2, 0xe9, 0x85, 0x4a,
+	0x47, 0x07, 0x17, 0x91, 0xbc, 0xd6, 0x24, 0x42, 0x54, 0x35, 0x13, 0x3d, 0xd2, 0xc6, 0x05, 0x9c,
+	0xf7, 0xa7, 0x05, 0x2d, 0x63, 0x78, 0xa2, 0x9b, 0x00, 0x47, 0xd1, 0x24, 0xa1, 0x73, 0x1a, 0xa5,
+	0x4c, 0x75, 0x85, 0x81, 0xe1, 0xf4, 0x3d, 0x9a, 0xd1, 0xa5, 0x27, 0x06, 0x06, 0xdd, 0x87, 0xe6,
+	0x38, 0x21, 0x11, 0x3b, 0xa3, 0xc9, 0x25, 0xef, 0x8f, 0xba, 0x65, 0x90, 0xb1, 0xc8, 0xc0, 0xe6,
+	0x22, 0xee, 0x57, 0xd0, 0x2d, 0x12, 0xdf, 0x29, 0xcd, 0x3b, 0xb2, 0xd0, 0xb3, 0xf7, 0xa9, 0xd0,
+	0xde, 0x6d, 0xdd, 0xde, 0x85, 0x59, 0x59, 0x5a, 0x9b, 0x95, 0xde, 0x47, 0xd0, 0xe4, 0x3a, 0xf6,
+	0xe8, 0x84, 0xac, 0xe4, 0xfb, 0x15, 0x9e, 0x1d, 0x07, 0x67, 0x7a, 0x44, 0x64, 0xb0, 0xf7, 0x00,
+	0x80, 0x33, 0x3e, 0x0d, 0x22, 0x3f, 0x7e, 0x25, 0x06, 0x57, 0xf0, 0xa3, 0xe6, 0x12, 0x67, 0x1e,
+	0x2c, 0x59, 0x13, 0x82, 0xa2, 0x82, 0x95, 0x63, 0xbc, 0x1f, 0xa4, 0x86, 0x53, 0x9a, 0x04, 0xb1,
+	0x8f, 0x6e, 0x43, 0xed, 0x98, 0x46, 0xd3, 0x74, 0x26, 0x74, 0x74, 0xf5, 0xd0, 0x95, 0x54, 0x49,
+	0xc1, 0x8a, 0x83, 0x6b, 0x7e, 0x16, 0x47, 0xf4, 0xf1, 0xd9, 0x19, 0xa3, 0xa9, 0xd6, 0x9c, 0x63,
+	0xbc, 0x2f, 0xa4, 0xe6, 0x11, 0x4d, 0xc7, 0x64, 0xca, 0x43, 0x38, 0xa2, 0x2f, 0x85, 0xda, 0x0a,
+	0x7f, 0x97, 0x5f, 0x1a, 0x83, 0xb3, 0x64, 0x0e, 0x4e, 0xef, 0x7b, 0xe8, 0xe8, 0xf7, 0x9b, 0xce,
+	0xe3, 0x73, 0x31, 0x49, 0xf9, 0xd2, 0x70, 0xb4, 0xa7, 0x12, 0xa0, 0x20, 0xad, 0xb2, 0x74, 0x99,
+	0xca, 0xc2, 0x2c, 0xf6, 0x7e, 0xb7, 0xa0, 0xae, 0x74, 0xa2, 0x3b, 0x50, 0x79, 0xe8, 0x67, 0xf3,
+	0xf2, 0xbf, 0x85, 0x85, 0x61, 0xc0, 0x29, 0xb2, 0x1e, 0x04, 0x13, 0xfa, 0x04, 0xea, 0xd2, 0x08,
+	0x3d, 0x6d, 0xb6, 0x0a, 0xfc, 0x92, 0x86, 0x35, 0x8f, 0xb6, 0xa8, 0x9c, 0x59, 0xe4, 0x1e, 0x41,
+	0x33, 0xd3, 0x79, 0x49, 0x19, 0x7d, 0x58, 0x9c, 0xa2, 0x76, 0x41, 0xfb, 0x98, 0x4c, 0xcd, 0xc2,
+	0xfa, 0xa3, 0x2c, 0x2b, 0xeb, 0x09, 0x49, 0x02, 0xf2, 0x3c, 0xa4, 0x68, 0x1f, 0x5a, 0x3c, 0x12,
+	0x4c, 0xb5, 0x96, 0x74, 0xe8, 0xbd, 0x5c, 0x85, 0x66, 0x1c, 0x18, 0x5c, 0xd2, 0x39, 0x53, 0x8e,
+	0x5b, 0x35, 0x1e, 0x1f, 0xab, 0x24, 0xf0, 0x23, 0xcf, 0xec, 0x78, 0x7c, 0xac, 0x9f, 0x07, 0x19,
+	0x4a, 0x03, 0x83, 0x6e, 0x42, 0xe5, 0xbb, 0x20, 0xf2, 0xc5, 0xc3, 0xd6, 0x1d, 0x82, 0xbc, 0x91,
+	0x63, 0xb0, 0xc0, 0xa3, 0x3e, 0xd4, 0x64, 0x45, 0x3a, 0xd5, 0x75, 0xb7, 0x24, 0x1e, 0x2b, 0x3a,
+	0xe7, 0x94, 0xb5, 0xe5, 0xd4, 0xd6, 0x39, 0x25, 0x1e, 0x2b, 0x3a, 0x5f, 0x08, 0x1e, 0x9f, 0xd3,
+	0xe4, 0x2c, 0x8c, 0x5f, 0x39, 0xf5, 0xf5, 0x85, 0x40, 0x53, 0x70, 0xc6, 0x83, 0x3e, 0x80, 0xaa,
+	0x68, 0x1f, 0xb1, 0xbf, 0xb5, 0x86, 0x1b, 0x39, 0xb3, 0x40, 0x63, 0x49, 0xe5, 0xae, 0xee, 0x2e,
+	0x59, 0x1a, 0xcf, 0xc7, 0xab, 0x05, 0x15, 0xfb, 0x5c, 0x13, 0x1b, 0x18, 0x77, 0x04, 0xf6, 0x7a,
+	0xf4, 0xde, 0xe9, 0x31, 0xcc, 0xb6, 0x5d, 0x33, 0x93, 0x0f, 0x64, 0x22, 0x33, 0x5b, 0x6f, 0x40,
+	0xed, 0x34, 0x0e, 0x83, 0x89, 0x1e, 0xd5, 0x0a, 0xba, 0x62, 0x07, 0xf8, 0x4d, 0x8d, 0x4c, 0xcc,
+	0x77, 0x16, 0x96, 0xbe, 0xb1, 0x45, 0xee, 0x43, 0x53, 0x57, 0x81, 0xae, 0xe0, 0x9e, 0xb9, 0x4d,
+	0x0b, 0xe9, 0x41, 0xc6, 0xa2, 0x46, 0x61, 0x06, 0xbb, 0xa7, 0xd0, 0x2d, 0x12, 0x2f, 0x71, 0xbe,
+	0x5f, 0x74, 0x1e, 0xbd, 0x5e, 0x80, 0xa6, 0xef, 0xdb, 0x7a, 0x3c, 0xb2, 0x45, 0x1c, 0x31, 0xb1,
+	0x6c, 0xed, 0xc6, 0x7e, 0x36, 0xb3, 0xf8, 0x99, 0x7b, 0x83, 0x29, 0x5b, 0x45, 0x13, 0x61, 0x72,
+	0x13, 0x2b, 0xc8, 0x1b, 0x41, 0x07, 0x07, 0xd3, 0x59, 0xca, 0xde, 0xe6, 0x36, 0x82, 0xca, 0x23,
+	0x32, 0x97, 0x16, 0x35, 0xb1, 0x38, 0x73, 0xde, 0x87, 0x73, 0xb1, 0xb4, 0xaa, 0xd9, 0x20, 0x21,
+	0x2f, 0x80, 0xae, 0x56, 0x7a, 0x85, 0x49, 0x0e, 0xd4, 0xbf, 0x4d, 0x48, 0x94, 0xe6, 0xfb, 0x9f,
+	0x02, 0x79, 0xee, 0xe5, 0x7c, 0x2f, 0x5f, 0x91, 0x7b, 0xf1, 0xc3, 0x7d, 0x3f, 0xa0, 0xe9, 0x64,
+	0xf6, 0x2f, 0xcc, 0xe7, 0xbe, 0x2b, 0xd9, 0x2b, 0xac, 0x1c, 0x40, 0x43, 0xc7, 0xfc, 0x8a, 0x6c,
+	0x64, 0x3c, 0x5e, 0x17, 0xda, 0x87, 0x34, 0x0c, 0x63, 0x65, 0x90, 0x77, 0x0b, 0x3a, 0x0a, 0x56,
+	0x97, 0x74, 0xa1, 0x94, 0x59, 0x57, 0x3a, 0xda, 0xbb, 0xfd, 0x73, 0x49, 0xb6, 0x3e, 0x6a, 0x41,
+	0x5d, 0x6c, 0x5b, 0x34, 0xb1, 0xaf, 0x21, 0xd0, 0xfd, 0x6e, 0x5b, 0xfc, 0x2c, 0x3b, 0xd6, 0x2e,
+	0xa1, 0x76, 0xfe, 0x29, 0x67, 0x97, 0x51, 0x5d, 0x7c, 0xa8, 0xd9, 0x15, 0x7e, 0x38, 0x21, 0x17,
+	0x76, 0x55, 0x1c, 0x82, 0xc8, 0xae, 0xa1, 0xa6, 0xfa, 0x0c, 0xb3, 0xeb, 0x5c, 0xb1, 0x7a, 0xa1,
+	0xed, 0x06, 0xda, 0x28, 0x7c, 0x3d, 0xd9, 0x4d, 0xd4, 0x31, 0xbe, 0x8a, 0x6c, 0xe0, 0x17, 0xe8,
+	0x9d, 0xcf, 0x6e, 0xa1, 0x86, 0xfc, 0x9e, 0xb1, 0xdb, 0x9c, 0x6d, 0x14, 0x27, 0x29, 0xf5, 0xf9,
+	0x85, 0x1d, 0xae, 0xf3, 0xe1, 0x39, 0x4d, 0xc8, 0x94, 0xda, 0x5d, 0x7e, 0x97, 0x68, 0x7d, 0x7b,
+	0x03, 0x21, 0xe8, 0xee, 0x47, 0x3c, 0x10, 0x4f, 0x83, 0x88, 0x1d, 0x84, 0x64, 0x6a, 0xdb, 0x68,
+	0x0b, 0x36, 0xf6, 0x02, 0x56, 0x40, 0x6e, 0x72, 0xa7, 0xe4, 0x4c, 0xb0, 0x11, 0xbf, 0x53, 0x7f,
+	0x29, 0xd8, 0x5b, 0xb7, 0x87, 0xd0, 0x36, 0x1f, 0x47, 0xce, 0x79, 0x18, 0x2f, 0x93, 0x70, 0x65,
+	0x5f, 0x13, 0x37, 0x91, 0x20, 0x5c, 0xd9, 0x16, 0xb7, 0xe0, 0x24, 0x8e, 0xd2, 0x59, 0xb8, 0xb2,
+	0x4b, 0xc3, 0xbf, 0x2c, 0x00, 0x4c, 0x17, 0x61, 0x30, 0x21, 0x69, 0x9c, 0xa0, 0x21, 0x54, 0x45,
+	0xd0, 0x91, 0xca, 0x95, 0x99, 0x11, 0x77, 0xab, 0x80, 0x93, 0x59, 0xf1, 0xae, 0xa1, 0x4f, 0xa1,
+	0xc2, 0x53, 0x8a, 0x36, 0x5f, 0x6b, 0x66, 0xb7, 0xf0, 0xb5, 0x9c, 0x09, 0x7c, 0x0e, 0x35, 0x59,
+	0xe5, 0x48, 0x69, 0x2c, 0x34, 0x92, 0x7b, 0xbd, 0x88, 0xcc, 0xc4, 0x86, 0x50, 0x15, 0x55, 0xa7,
+	0x6d, 0x33, 0xcb, 0xd7, 0xdd, 0x2a, 0xe0, 0xb4, 0xcc, 0xf3, 0x9a, 0xf8, 0x2f, 0xe1, 0xee, 0x3f,
+	0x03, 0x00, 0xff, 0xdf, 0x77, 0x88, 0x5c, 0x10, 0x00, 0x00,
+}
+
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
@@ -302,6 +1747,8 @@ const _ = grpc.SupportPackageIsVersion4
 type ReplicatorClient interface {
 	Hello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloResponse, error)
 	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error)
+	Rights(ctx context.Context, in *RightsRequest, opts ...grpc.CallOption) (*RightsResponse, error)
+	Fetch(ctx context.Context, in *FetchRequest, opts ...grpc.CallOption) (*FetchResponse, error)
 }
 
 type replicatorClient struct {
@@ -330,10 +1777,47 @@ func (c *replicatorClient) Sync(ctx context.Context, in *SyncRequest, opts ...gr
 	return out, nil
 }
 
+func (c *replicatorClient) Rights(ctx context.Context, in *RightsRequest, opts ...grpc.CallOption) (*RightsResponse, error) {
+	out := new(RightsResponse)
+	err := c.cc.Invoke(ctx, "/rplx.Replicator/Rights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicatorClient) Fetch(ctx context.Context, in *FetchRequest, opts ...grpc.CallOption) (*FetchResponse, error) {
+	out := new(FetchResponse)
+	err := c.cc.Invoke(ctx, "/rplx.Replicator/Fetch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReplicatorServer is the server API for Replicator service.
 type ReplicatorServer interface {
 	Hello(context.Context, *HelloRequest) (*HelloResponse, error)
 	Sync(context.Context, *SyncRequest) (*SyncResponse, error)
+	Rights(context.Context, *RightsRequest) (*RightsResponse, error)
+	Fetch(context.Context, *FetchRequest) (*FetchResponse, error)
+}
+
+// UnimplementedReplicatorServer can be embedded to have forward compatible implementations.
+type UnimplementedReplicatorServer struct {
+}
+
+func (*UnimplementedReplicatorServer) Hello(ctx context.Context, req *HelloRequest) (*HelloResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hello not implemented")
+}
+func (*UnimplementedReplicatorServer) Sync(ctx context.Context, req *SyncRequest) (*SyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
+}
+func (*UnimplementedReplicatorServer) Rights(ctx context.Context, req *RightsRequest) (*RightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rights not implemented")
+}
+func (*UnimplementedReplicatorServer) Fetch(ctx context.Context, req *FetchRequest) (*FetchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fetch not implemented")
 }
 
 func RegisterReplicatorServer(s *grpc.Server, srv ReplicatorServer) {
@@ -376,6 +1860,42 @@ func _Replicator_Sync_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Replicator_Rights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicatorServer).Rights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rplx.Replicator/Rights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicatorServer).Rights(ctx, req.(*RightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Replicator_Fetch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicatorServer).Fetch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rplx.Replicator/Fetch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicatorServer).Fetch(ctx, req.(*FetchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Replicator_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rplx.Replicator",
 	HandlerType: (*ReplicatorServer)(nil),
@@ -388,36 +1908,15 @@ var _Replicator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Sync",
 			Handler:    _Replicator_Sync_Handler,
 		},
+		{
+			MethodName: "Rights",
+			Handler:    _Replicator_Rights_Handler,
+		},
+		{
+			MethodName: "Fetch",
+			Handler:    _Replicator_Fetch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "message.proto",
 }
-
-func init() { proto.RegisterFile("message.proto", fileDescriptor_message_e443170929639c88) }
-
-var fileDescriptor_message_e443170929639c88 = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcd, 0x4e, 0xf2, 0x40,
-	0x14, 0xfd, 0xda, 0x02, 0x5f, 0xb8, 0x05, 0x82, 0x17, 0x63, 0x9a, 0x2e, 0x94, 0x8c, 0x1b, 0xdc,
-	0xd4, 0xa4, 0x6e, 0x8c, 0x0b, 0x5d, 0x08, 0x89, 0x24, 0xc4, 0x98, 0xa1, 0x61, 0x5f, 0x60, 0x62,
-	0x88, 0xb5, 0x53, 0x3a, 0xc5, 0xc8, 0x93, 0xf9, 0x32, 0x3e, 0x8c, 0x99, 0xe9, 0x14, 0x06, 0x71,
-	0x77, 0x7f, 0xce, 0x39, 0x3d, 0xe7, 0x4e, 0xa1, 0xfd, 0xce, 0x84, 0x88, 0x5f, 0x59, 0x90, 0xe5,
-	0xbc, 0xe0, 0x58, 0xcb, 0xb3, 0xe4, 0x93, 0x3c, 0x40, 0x7b, 0xba, 0x4d, 0x17, 0xcf, 0x7c, 0xc9,
-	0x66, 0x71, 0xb2, 0x61, 0x78, 0x0a, 0x75, 0x55, 0x78, 0x56, 0xdf, 0x1a, 0x38, 0xb4, 0x6c, 0xd0,
-	0x83, 0xff, 0x33, 0x96, 0x8b, 0x15, 0x4f, 0x3d, 0x5b, 0xcd, 0xab, 0x96, 0x7c, 0x5b, 0xd0, 0x92,
-	0x0a, 0xb3, 0x38, 0x5f, 0xc5, 0xf3, 0x84, 0xe1, 0x08, 0x5c, 0xa9, 0x26, 0x14, 0x51, 0x78, 0x56,
-	0xdf, 0x19, 0xb8, 0xe1, 0x65, 0x20, 0xbf, 0x16, 0x98, 0xc0, 0xc0, 0x40, 0x8d, 0xd2, 0x22, 0xdf,
-	0x52, 0x93, 0x87, 0x5d, 0x70, 0xa2, 0x68, 0xa2, 0xbf, 0x26, 0x4b, 0x3c, 0x07, 0x88, 0xa2, 0x49,
-	0x65, 0xc3, 0x51, 0x0b, 0x63, 0xe2, 0x4f, 0xa1, 0xfb, 0x5b, 0x52, 0xaa, 0xbc, 0xb1, 0xad, 0xca,
-	0xd2, 0xa4, 0xb2, 0xc4, 0x2b, 0xa8, 0x7f, 0xa8, 0x7c, 0x52, 0xd9, 0x0d, 0x7b, 0x7b, 0x63, 0xbb,
-	0x1b, 0xd0, 0x12, 0x71, 0x67, 0xdf, 0x5a, 0xe4, 0xcb, 0x02, 0x57, 0x2e, 0x29, 0x5b, 0x6f, 0x98,
-	0x28, 0xf0, 0x0c, 0x1a, 0x12, 0x37, 0x1e, 0x6a, 0x4d, 0xdd, 0xe1, 0x3d, 0x34, 0xab, 0x60, 0xc2,
-	0xb3, 0x55, 0xe6, 0xfe, 0x5e, 0x5a, 0xb3, 0x83, 0x1d, 0xa4, 0x0c, 0xbc, 0xa7, 0xf8, 0x2f, 0xd0,
-	0x39, 0x5c, 0xfe, 0x61, 0x7d, 0x70, 0x68, 0x1d, 0x8f, 0x6f, 0x6a, 0x3a, 0x27, 0xe5, 0xbb, 0x50,
-	0x26, 0x32, 0x9e, 0x0a, 0x86, 0x08, 0xb5, 0x47, 0xbe, 0xac, 0xde, 0x55, 0xd5, 0xa4, 0x03, 0xad,
-	0x27, 0x96, 0x24, 0x5c, 0xfb, 0x23, 0x17, 0xd0, 0xd6, 0xbd, 0x26, 0x75, 0xc0, 0xde, 0x45, 0xb5,
-	0xc7, 0xc3, 0x70, 0x0d, 0x40, 0x59, 0x96, 0xac, 0x16, 0x71, 0xc1, 0x73, 0x0c, 0xa1, 0xae, 0xe0,
-	0xa8, 0xad, 0x98, 0x5a, 0x7e, 0xef, 0x60, 0x56, 0xea, 0x91, 0x7f, 0x78, 0x0d, 0x35, 0x69, 0x0b,
-	0x4f, 0x8e, 0xae, 0xe3, 0xa3, 0x39, 0xaa, 0x08, 0xf3, 0x86, 0xfa, 0x5d, 0x6f, 0x7e, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x8f, 0xb8, 0xcd, 0x4e, 0xbf, 0x02, 0x00, 0x00,
-}
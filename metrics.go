@@ -9,6 +9,8 @@ type metrics struct {
 	variablesSent              *prometheus.CounterVec
 	variablesSentResponseCodes *prometheus.CounterVec
 	variablesSentDuration      *prometheus.HistogramVec
+	watchEventsDropped         *prometheus.CounterVec
+	counterOverflows           *prometheus.CounterVec
 }
 
 func newMetrics() *metrics {
@@ -35,6 +37,16 @@ func newMetrics() *metrics {
 		Buckets: []float64{0.01, 0.05, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1, 2, 5},
 	}, []string{"remote_node_id"})
 
+	m.watchEventsDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "rplx_watch_events_dropped",
+		Help: "Rplx Watch Events Dropped",
+	}, []string{"type"})
+
+	m.counterOverflows = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "rplx_counter_overflows",
+		Help: "Rplx Counter Overflows",
+	}, []string{"type"})
+
 	return m
 }
 
@@ -43,4 +55,6 @@ func (m *metrics) register() {
 	prometheus.MustRegister(m.variablesSent)
 	prometheus.MustRegister(m.variablesSentResponseCodes)
 	prometheus.MustRegister(m.variablesSentDuration)
+	prometheus.MustRegister(m.watchEventsDropped)
+	prometheus.MustRegister(m.counterOverflows)
 }
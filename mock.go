@@ -74,6 +74,46 @@ func (mr *MockReplicatorClientMockRecorder) Sync(ctx, in interface{}, opts ...in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockReplicatorClient)(nil).Sync), varargs...)
 }
 
+// Rights mocks base method
+func (m *MockReplicatorClient) Rights(ctx context.Context, in *RightsRequest, opts ...grpc.CallOption) (*RightsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Rights", varargs...)
+	ret0, _ := ret[0].(*RightsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rights indicates an expected call of Rights
+func (mr *MockReplicatorClientMockRecorder) Rights(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rights", reflect.TypeOf((*MockReplicatorClient)(nil).Rights), varargs...)
+}
+
+// Fetch mocks base method
+func (m *MockReplicatorClient) Fetch(ctx context.Context, in *FetchRequest, opts ...grpc.CallOption) (*FetchResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Fetch", varargs...)
+	ret0, _ := ret[0].(*FetchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch
+func (mr *MockReplicatorClientMockRecorder) Fetch(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockReplicatorClient)(nil).Fetch), varargs...)
+}
+
 // MockReplicatorServer is a mock of ReplicatorServer interface
 type MockReplicatorServer struct {
 	ctrl     *gomock.Controller
@@ -126,3 +166,33 @@ func (mr *MockReplicatorServerMockRecorder) Sync(arg0, arg1 interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockReplicatorServer)(nil).Sync), arg0, arg1)
 }
+
+// Rights mocks base method
+func (m *MockReplicatorServer) Rights(arg0 context.Context, arg1 *RightsRequest) (*RightsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rights", arg0, arg1)
+	ret0, _ := ret[0].(*RightsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rights indicates an expected call of Rights
+func (mr *MockReplicatorServerMockRecorder) Rights(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rights", reflect.TypeOf((*MockReplicatorServer)(nil).Rights), arg0, arg1)
+}
+
+// Fetch mocks base method
+func (m *MockReplicatorServer) Fetch(arg0 context.Context, arg1 *FetchRequest) (*FetchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch", arg0, arg1)
+	ret0, _ := ret[0].(*FetchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch
+func (mr *MockReplicatorServerMockRecorder) Fetch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockReplicatorServer)(nil).Fetch), arg0, arg1)
+}
@@ -43,6 +43,11 @@ type node struct {
 	replicatedVersionsMx sync.RWMutex
 	replicatedVersions   map[string]int64
 
+	// ackWaiters contains UpsertAck waiters, woken by sync loop, when remote node acknowledged data version
+	// map key format is the same as for replicatedVersions
+	ackWaitersMx sync.Mutex
+	ackWaiters   map[string][]*ackWaiter
+
 	syncInterval       time.Duration
 	connectionInterval time.Duration
 
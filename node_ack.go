@@ -0,0 +1,121 @@
+package rplx
+
+import (
+	"context"
+	"time"
+)
+
+// ackWaiter describe UpsertAck waiter of data version acknowledgement
+type ackWaiter struct {
+	version int64
+	ch      chan struct{}
+}
+
+// addAckWaiter registers waiter of acknowledgement of data version with key
+// returns channel, which is closed after acknowledgement, and function for remove waiter
+func (n *node) addAckWaiter(key string, version int64) (chan struct{}, func()) {
+	w := &ackWaiter{
+		version: version,
+		ch:      make(chan struct{}),
+	}
+
+	n.ackWaitersMx.Lock()
+	defer n.ackWaitersMx.Unlock()
+
+	// version may be acknowledged before waiter registration
+	// check is done under ackWaitersMx, so acknowledgement between check and registration is not missed
+	if n.replicatedVersion(key) >= version {
+		close(w.ch)
+		return w.ch, func() {}
+	}
+
+	if n.ackWaiters == nil {
+		n.ackWaiters = make(map[string][]*ackWaiter)
+	}
+	n.ackWaiters[key] = append(n.ackWaiters[key], w)
+
+	return w.ch, func() {
+		n.ackWaitersMx.Lock()
+		defer n.ackWaitersMx.Unlock()
+
+		waiters := n.ackWaiters[key]
+		for i, o := range waiters {
+			if o == w {
+				waiters = append(waiters[:i], waiters[i+1:]...)
+				break
+			}
+		}
+
+		if len(waiters) == 0 {
+			delete(n.ackWaiters, key)
+			return
+		}
+		n.ackWaiters[key] = waiters
+	}
+}
+
+// notifyAcked wakes up waiters of acknowledged versions, called by sync loop after successful sync
+func (n *node) notifyAcked(versions map[string]int64) {
+	n.ackWaitersMx.Lock()
+	defer n.ackWaitersMx.Unlock()
+
+	for key, version := range versions {
+		waiters, ok := n.ackWaiters[key]
+		if !ok {
+			continue
+		}
+
+		rest := waiters[:0]
+		for _, w := range waiters {
+			if w.version <= version {
+				close(w.ch)
+				continue
+			}
+			rest = append(rest, w)
+		}
+
+		if len(rest) == 0 {
+			delete(n.ackWaiters, key)
+			continue
+		}
+		n.ackWaiters[key] = rest
+	}
+}
+
+// replicatedVersion returns version of data with key, acknowledged by remote node
+func (n *node) replicatedVersion(key string) int64 {
+	n.replicatedVersionsMx.RLock()
+	defer n.replicatedVersionsMx.RUnlock()
+
+	return n.replicatedVersions[key]
+}
+
+// waitAck waits until remote node acknowledged local node data of variable with version
+// variable is sent by sync loop, failed sync drops variables from buffer,
+// so not acknowledged variable is returned to buffer each sync interval
+// returns false, if ctx is done before acknowledgement
+func (n *node) waitAck(ctx context.Context, v *variable, version int64) bool {
+	acked, remove := n.addAckWaiter(v.name+"@"+n.localNodeID, version)
+	defer remove()
+
+	interval := n.syncInterval
+	if interval <= 0 {
+		interval = defaultRemoteNodeSyncInterval
+	}
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-acked:
+			return true
+		case <-t.C:
+			n.bufferMx.Lock()
+			n.buffer[v.name] = v
+			n.bufferMx.Unlock()
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package rplx
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+// newAckTestNode returns connected node with running sync loop
+func newAckTestNode(remoteNodeID string, client ReplicatorClient) *node {
+	n := &node{
+		logger:             zap.NewNop(),
+		connected:          1,
+		localNodeID:        "node1",
+		remoteNodeID:       remoteNodeID,
+		replicatorClient:   client,
+		replicationChan:    make(chan *variable, 10),
+		buffer:             make(map[string]*variable),
+		replicatedVersions: make(map[string]int64),
+		syncInterval:       10 * time.Millisecond,
+		syncQueue:          make(chan struct{}, 1),
+		stopChan:           make(chan struct{}),
+		metrics:            newMetrics(),
+	}
+
+	go n.listenSyncQueue()
+	go n.listenReplicationChannel()
+	go n.syncByTicker()
+
+	return n
+}
+
+func TestRplx_UpsertAck(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	okClient := &replicatorClientMock{}
+	okClient.On("Sync", mock.Anything, mock.Anything, mock.Anything).Return(&SyncResponse{Code: syncCodeSuccess}, nil)
+
+	failClient := &replicatorClientMock{}
+	failClient.On("Sync", mock.Anything, mock.Anything, mock.Anything).Return(&SyncResponse{}, errors.New("unavailable"))
+
+	n2 := newAckTestNode("node2", okClient)
+	defer close(n2.stopChan)
+	n3 := newAckTestNode("node3", failClient)
+	defer close(n3.stopChan)
+
+	r.nodesMx.Lock()
+	r.nodes["addr2"] = n2
+	r.nodes["addr3"] = n3
+	r.nodesMx.Unlock()
+
+	value, confirmed, err := r.UpsertAck(context.Background(), "A", 5, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), value)
+	assert.Equal(t, []string{"node2"}, confirmed)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	value, confirmed, err = r.UpsertAck(ctx, "A", 1, 2)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, int64(6), value)
+	assert.Equal(t, []string{"node2"}, confirmed)
+
+	_, confirmed, err = r.UpsertAck(context.Background(), "A", 1, 0)
+	require.NoError(t, err)
+	assert.Empty(t, confirmed)
+
+	// not enough connected nodes, variable is not changed
+	_, _, err = r.UpsertAck(context.Background(), "A", 1, 3)
+	assert.Equal(t, ErrNotEnoughPeers, err)
+
+	value, err = r.Get("A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), value)
+}
+
+func TestNode_AckWaiters(t *testing.T) {
+	n := &node{
+		localNodeID:        "node1",
+		replicatedVersions: map[string]int64{"A@node1": 5},
+	}
+
+	// acknowledged version
+	ch, remove := n.addAckWaiter("A@node1", 5)
+	remove()
+	select {
+	case <-ch:
+	default:
+		t.Fatal("waiter of acknowledged version is not woken")
+	}
+
+	ch6, _ := n.addAckWaiter("A@node1", 6)
+	ch7, remove7 := n.addAckWaiter("A@node1", 7)
+	chB, removeB := n.addAckWaiter("B@node1", 1)
+
+	n.notifyAcked(map[string]int64{"A@node1": 6})
+
+	select {
+	case <-ch6:
+	default:
+		t.Fatal("waiter of acknowledged version is not woken")
+	}
+	select {
+	case <-ch7:
+		t.Fatal("waiter of not acknowledged version is woken")
+	case <-chB:
+		t.Fatal("waiter of other key is woken")
+	default:
+	}
+
+	remove7()
+	removeB()
+	assert.Empty(t, n.ackWaiters)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"go.uber.org/zap"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"time"
 )
@@ -55,26 +56,57 @@ func (n *node) sendSyncRequest() error {
 	}
 
 	replicatedVersions := make(map[string]int64)
+	sent := make(map[string]*variable)
 
 	n.replicatedVersionsMx.RLock()
 	for name, v := range n.buffer {
+		sent[name] = v
+
 		sv := &SyncVariable{
 			TTL:         v.TTL(),
 			TTLVersion:  v.TTLVersion(),
 			NodesValues: make(map[string]*SyncNodeValue),
 		}
 
+		v.syncOptions(sv)
+
+		if v.kind != Kind_Counter {
+			v.eachPart(n.localNodeID, func(nodeID string, p part) {
+				// Dont send to remote node its data
+				if nodeID == n.remoteNodeID || n.replicatedVersions[name+"@"+nodeID] >= p.version() {
+					return
+				}
+
+				var value *SyncNodeValue
+				if d, ok := p.(deltaPart); ok {
+					value = d.syncDelta(n.replicatedVersions[name+"@"+nodeID])
+				} else {
+					value = p.syncValue()
+				}
+				sv.NodesValues[nodeID] = value
+				replicatedVersions[name+"@"+nodeID] = value.Version
+			})
+
+			if len(sv.NodesValues) > 0 {
+				req.Variables[name] = sv
+			}
+
+			delete(n.buffer, name)
+			continue
+		}
+
 		lastReplicatedVersion, ok := n.replicatedVersions[name+"@"+n.localNodeID]
 		if !ok {
 			lastReplicatedVersion = 0
 		}
 
-		if lastReplicatedVersion < v.self.version() {
+		if value, high, version := v.self.snapshot(); lastReplicatedVersion < version {
 			sv.NodesValues[n.localNodeID] = &SyncNodeValue{
-				Value:   v.self.value(),
-				Version: v.self.version(),
+				Value:     value,
+				ValueHigh: high,
+				Version:   version,
 			}
-			replicatedVersions[name+"@"+n.localNodeID] = v.self.version()
+			replicatedVersions[name+"@"+n.localNodeID] = version
 		}
 
 		v.remoteItemsMx.RLock()
@@ -90,12 +122,13 @@ func (n *node) sendSyncRequest() error {
 				lastReplicatedVersion = 0
 			}
 
-			if lastReplicatedVersion < item.version() {
+			if value, high, version := item.snapshot(); lastReplicatedVersion < version {
 				sv.NodesValues[nodeID] = &SyncNodeValue{
-					Value:   item.value(),
-					Version: item.version(),
+					Value:     value,
+					ValueHigh: high,
+					Version:   version,
 				}
-				replicatedVersions[name+"@"+nodeID] = item.version()
+				replicatedVersions[name+"@"+nodeID] = version
 			}
 		}
 		v.remoteItemsMx.RUnlock()
@@ -140,7 +173,28 @@ func (n *node) sendSyncRequest() error {
 	for key, stamp := range replicatedVersions {
 		n.replicatedVersions[key] = stamp
 	}
+	// remote node has not applied base version of changes, full state is sent with the next sync
+	for _, key := range r.Resync {
+		delete(n.replicatedVersions, key)
+		delete(replicatedVersions, key)
+	}
 	n.replicatedVersionsMx.Unlock()
 
+	if len(r.Resync) > 0 {
+		n.bufferMx.Lock()
+		for _, key := range r.Resync {
+			name := key
+			if i := strings.LastIndex(key, "@"); i >= 0 {
+				name = key[:i]
+			}
+			if _, buffered := n.buffer[name]; !buffered && sent[name] != nil {
+				n.buffer[name] = sent[name]
+			}
+		}
+		n.bufferMx.Unlock()
+	}
+
+	n.notifyAcked(replicatedVersions)
+
 	return nil
 }
@@ -0,0 +1,200 @@
+package rplx
+
+import (
+	"math"
+	"math/big"
+	"sync/atomic"
+	"time"
+)
+
+// OverflowPolicy describe behavior of Counter variable on int64 overflow
+type OverflowPolicy int32
+
+const (
+	// OverflowWrap - value wraps around, as int64 arithmetic does (default)
+	OverflowWrap OverflowPolicy = iota
+	// OverflowSaturate - value stops at math.MaxInt64 or math.MinInt64
+	OverflowSaturate
+	// OverflowError - update, which overflows node value, is rejected with ErrOverflow,
+	// Get returns saturated value with ErrOverflow, if total value overflows
+	OverflowError
+	// OverflowBig - values are stored with arbitrary precision, exact value is returned by GetBig,
+	// Get returns saturated value with ErrOverflow, if total value overflows
+	OverflowBig
+)
+
+var two64 = new(big.Int).Lsh(big.NewInt(1), 64)
+
+// addInt64 returns a + b and true, if result overflows int64
+func addInt64(a, b int64) (int64, bool) {
+	result := a + b
+
+	return result, (b > 0 && result < a) || (b < 0 && result > a)
+}
+
+// saturate returns math.MaxInt64 for positive overflow and math.MinInt64 for negative
+func saturate(positive bool) int64 {
+	if positive {
+		return math.MaxInt64
+	}
+
+	return math.MinInt64
+}
+
+// toBig returns high * 2^64 + value
+func toBig(value, high int64) *big.Int {
+	result := big.NewInt(high)
+	result.Mul(result, two64)
+
+	return result.Add(result, big.NewInt(value))
+}
+
+// add adds delta to item value with overflow policy
+// returns true, if node value overflows, and ErrOverflow, if update was rejected
+// version is changed only, if update is applied
+func (item *variableItem) add(delta int64, policy OverflowPolicy) (bool, error) {
+	item.mx.Lock()
+	defer item.mx.Unlock()
+
+	value, overflow := addInt64(item.val, delta)
+
+	if overflow {
+		switch policy {
+		case OverflowError:
+			return true, ErrOverflow
+		case OverflowSaturate:
+			value = saturate(delta > 0)
+		case OverflowBig:
+			// exact value is hi * 2^64 + val, wrapped val is compensated by hi
+			if delta > 0 {
+				atomic.AddInt64(&item.hi, 1)
+			} else {
+				atomic.AddInt64(&item.hi, -1)
+			}
+		}
+	}
+
+	atomic.StoreInt64(&item.val, value)
+	atomic.StoreInt64(&item.ver, time.Now().UTC().UnixNano())
+
+	return overflow, nil
+}
+
+// overflowPolicy returns overflow policy of variable
+func (v *variable) overflowPolicy() OverflowPolicy {
+	return OverflowPolicy(atomic.LoadInt32(&v.overflow))
+}
+
+// setOverflowPolicy sets overflow policy of variable
+func (v *variable) setOverflowPolicy(policy OverflowPolicy) {
+	v.overflowMx.Lock()
+	v.overflowVersion = nextVersion(v.overflowVersion)
+	atomic.StoreInt32(&v.overflow, int32(policy))
+	v.overflowMx.Unlock()
+
+	// self version is updated, so variable with new policy is replicated
+	v.self.update(0)
+	atomic.AddInt64(&v.changes, 1)
+}
+
+// overflowOptions returns overflow policy for replication, nil if policy was never set
+func (v *variable) overflowOptions() *SyncOverflow {
+	v.overflowMx.Lock()
+	defer v.overflowMx.Unlock()
+
+	if v.overflowVersion == 0 {
+		return nil
+	}
+
+	return &SyncOverflow{
+		Policy:  atomic.LoadInt32(&v.overflow),
+		Version: v.overflowVersion,
+	}
+}
+
+// mergeOverflow applies replicated overflow policy, if it is newer than local, returns true if policy was changed
+func (v *variable) mergeOverflow(o *SyncOverflow) bool {
+	if o == nil || o.Policy < int32(OverflowWrap) || o.Policy > int32(OverflowBig) {
+		return false
+	}
+
+	v.overflowMx.Lock()
+	defer v.overflowMx.Unlock()
+
+	if v.overflowVersion >= o.Version {
+		return false
+	}
+
+	v.overflowVersion = o.Version
+	atomic.StoreInt32(&v.overflow, o.Policy)
+	atomic.AddInt64(&v.changes, 1)
+
+	return true
+}
+
+// updateCounter adds delta to self value with variable overflow policy
+func (v *variable) updateCounter(delta int64) (bool, error) {
+	overflow, err := v.self.add(delta, v.overflowPolicy())
+	if err == nil {
+		atomic.AddInt64(&v.changes, 1)
+	}
+
+	return overflow, err
+}
+
+// counterBig returns exact sum of all nodes values
+func (v *variable) counterBig() *big.Int {
+	value, high, _ := v.self.snapshot()
+	result := toBig(value, high)
+
+	v.remoteItemsMx.RLock()
+	for _, item := range v.remoteItems {
+		value, high, _ := item.snapshot()
+		result.Add(result, toBig(value, high))
+	}
+	v.remoteItemsMx.RUnlock()
+
+	return result
+}
+
+// counterSum returns sum of all nodes values with variable overflow policy and true, if sum overflows int64
+func (v *variable) counterSum() (int64, bool) {
+	result, high, _ := v.self.snapshot()
+	overflow := high != 0
+
+	v.remoteItemsMx.RLock()
+	for _, item := range v.remoteItems {
+		value, high, _ := item.snapshot()
+
+		var itemOverflow bool
+		result, itemOverflow = addInt64(result, value)
+		overflow = overflow || itemOverflow || high != 0
+	}
+	v.remoteItemsMx.RUnlock()
+
+	if !overflow {
+		return result, false
+	}
+
+	// int64 sum may overflow and return back to int64 range, check with exact value
+	exact := v.counterBig()
+	if exact.IsInt64() {
+		return exact.Int64(), false
+	}
+
+	if v.overflowPolicy() == OverflowWrap {
+		return int64(new(big.Int).Mod(exact, two64).Uint64()), true
+	}
+
+	return saturate(exact.Sign() > 0), true
+}
+
+// markOverflow stores overflow state of variable sum, returns true, if sum was not overflowed before
+func (v *variable) markOverflow(overflow bool) bool {
+	if !overflow {
+		atomic.StoreInt32(&v.overflowed, 0)
+		return false
+	}
+
+	return atomic.CompareAndSwapInt32(&v.overflowed, 0, 1)
+}
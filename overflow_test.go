@@ -0,0 +1,220 @@
+package rplx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestVariableItem_Add(t *testing.T) {
+	type want struct {
+		value    int64
+		high     int64
+		overflow bool
+		err      error
+	}
+
+	tests := []struct {
+		name   string
+		policy OverflowPolicy
+		start  int64
+		delta  int64
+		want   want
+	}{
+		{"wrap", OverflowWrap, math.MaxInt64, 1, want{math.MinInt64, 0, true, nil}},
+		{"saturate up", OverflowSaturate, math.MaxInt64 - 1, 10, want{math.MaxInt64, 0, true, nil}},
+		{"saturate down", OverflowSaturate, math.MinInt64 + 1, -10, want{math.MinInt64, 0, true, nil}},
+		{"error", OverflowError, math.MaxInt64, 1, want{math.MaxInt64, 0, true, ErrOverflow}},
+		{"big up", OverflowBig, math.MaxInt64, 1, want{math.MinInt64, 1, true, nil}},
+		{"big down", OverflowBig, math.MinInt64, -1, want{math.MaxInt64, -1, true, nil}},
+		{"no overflow", OverflowError, 10, -20, want{-10, 0, false, nil}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := newVariableItem()
+			item.val = tt.start
+			item.ver = 1
+
+			overflow, err := item.add(tt.delta, tt.policy)
+
+			assert.Equal(t, tt.want.overflow, overflow)
+			assert.Equal(t, tt.want.err, err)
+			assert.Equal(t, tt.want.value, item.value())
+			assert.Equal(t, tt.want.high, item.high())
+
+			// rejected update does not change version
+			if err != nil {
+				assert.Equal(t, int64(1), item.version())
+			} else {
+				assert.True(t, item.version() > 1)
+			}
+		})
+	}
+}
+
+func TestVariableItem_AddBigConcurrent(t *testing.T) {
+	item := newVariableItem()
+	item.val = math.MaxInt64
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			_, _ = item.add(1, OverflowBig)
+			_, _ = item.add(-1, OverflowBig)
+		}
+	}()
+
+	low := big.NewInt(math.MaxInt64)
+	high := new(big.Int).Add(low, big.NewInt(1))
+
+	for i := 0; i < 1000; i++ {
+		value, hi, _ := item.snapshot()
+		exact := toBig(value, hi)
+		assert.True(t, exact.Cmp(low) == 0 || exact.Cmp(high) == 0, exact.String())
+	}
+
+	<-done
+}
+
+func TestVariable_CounterSum(t *testing.T) {
+	v := newVariable("A")
+	v.self.set(math.MaxInt64, 1)
+	v.updateItem("node2", 10, 0, 1)
+
+	value, overflow := v.counterSum()
+	assert.True(t, overflow)
+	assert.Equal(t, int64(math.MinInt64+9), value)
+
+	v.setOverflowPolicy(OverflowSaturate)
+	value, overflow = v.counterSum()
+	assert.True(t, overflow)
+	assert.Equal(t, int64(math.MaxInt64), value)
+
+	// sum returns to int64 range
+	v.updateItem("node3", -20, 0, 1)
+	value, overflow = v.counterSum()
+	assert.False(t, overflow)
+	assert.Equal(t, int64(math.MaxInt64-10), value)
+
+	expected := new(big.Int).SetInt64(math.MaxInt64)
+	expected.Sub(expected, big.NewInt(10))
+	assert.Equal(t, expected, v.counterBig())
+}
+
+func TestAPI_OverflowPolicy(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	require.NoError(t, r.SetOverflowPolicy("A", OverflowError))
+
+	value, err := r.UpsertChecked("A", math.MaxInt64)
+	require.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64), value)
+
+	value, err = r.UpsertChecked("A", 1)
+	assert.Equal(t, ErrOverflow, err)
+	assert.Equal(t, int64(math.MaxInt64), value)
+
+	// Upsert without error return keeps value
+	assert.Equal(t, int64(math.MaxInt64), r.Upsert("A", 1))
+
+	require.NoError(t, r.SetOverflowPolicy("B", OverflowBig))
+
+	_, err = r.UpsertChecked("B", math.MaxInt64)
+	require.NoError(t, err)
+
+	value, err = r.UpsertChecked("B", math.MaxInt64)
+	assert.Equal(t, ErrOverflow, err)
+	assert.Equal(t, int64(math.MaxInt64), value)
+
+	exact, err := r.GetBig("B")
+	require.NoError(t, err)
+	expected := new(big.Int).Mul(big.NewInt(math.MaxInt64), big.NewInt(2))
+	assert.Equal(t, 0, expected.Cmp(exact))
+
+	value, err = r.Get("B")
+	assert.Equal(t, ErrOverflow, err)
+	assert.Equal(t, int64(math.MaxInt64), value)
+
+	_, err = r.UpsertWindow("C", 1, 10, 1)
+	require.NoError(t, err)
+	assert.Equal(t, ErrVariableKind, r.SetOverflowPolicy("C", OverflowSaturate))
+}
+
+func TestRplx_SyncValueHigh(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	require.NoError(t, r.SetOverflowPolicy("A", OverflowBig))
+
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				NodesValues: map[string]*SyncNodeValue{
+					"node2": {Version: 1, Value: 5, ValueHigh: 1},
+				},
+			},
+		},
+	})
+
+	exact, err := r.GetBig("A")
+	require.NoError(t, err)
+	assert.Equal(t, "18446744073709551621", exact.String())
+}
+
+func TestRplx_SyncOverflowPolicy(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	r.Upsert("A", 1)
+
+	sv := &SyncVariable{}
+	r.variables["A"].syncOptions(sv)
+	assert.Nil(t, sv.Overflow)
+
+	require.NoError(t, r.SetOverflowPolicy("A", OverflowSaturate))
+
+	r.variables["A"].syncOptions(sv)
+	require.NotNil(t, sv.Overflow)
+	assert.Equal(t, int32(OverflowSaturate), sv.Overflow.Policy)
+
+	version := sv.Overflow.Version
+
+	sync := func(policy OverflowPolicy, version int64) {
+		r.sync(&SyncRequest{
+			NodeID: "node2",
+			Variables: map[string]*SyncVariable{
+				"A": {
+					Overflow: &SyncOverflow{Policy: int32(policy), Version: version},
+					NodesValues: map[string]*SyncNodeValue{
+						"node2": {Version: 1, Value: 5},
+					},
+				},
+			},
+		})
+	}
+
+	// older policy is ignored
+	sync(OverflowError, version-1)
+	assert.Equal(t, OverflowSaturate, r.variables["A"].overflowPolicy())
+
+	sync(OverflowBig, version+1)
+	assert.Equal(t, OverflowBig, r.variables["A"].overflowPolicy())
+
+	// unknown policy is ignored
+	sync(OverflowPolicy(10), version+2)
+	assert.Equal(t, OverflowBig, r.variables["A"].overflowPolicy())
+
+	// variable, created by replication, gets policy
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"B": {
+				Overflow: &SyncOverflow{Policy: int32(OverflowError), Version: 1},
+			},
+		},
+	})
+	assert.Equal(t, OverflowError, r.variables["B"].overflowPolicy())
+}
@@ -0,0 +1,50 @@
+package ratelimit
+
+import (
+	"time"
+)
+
+// FixedWindow limiter counts requests in fixed windows, aligned to Unix time
+// allows bursts up to 2 * limit on the windows border
+type FixedWindow struct {
+	storage Storage
+	prefix  string
+	now     func() time.Time
+}
+
+// NewFixedWindow creates FixedWindow limiter, prefix is used for rplx variables names
+func NewFixedWindow(storage Storage, prefix string) *FixedWindow {
+	return &FixedWindow{
+		storage: storage,
+		prefix:  prefix,
+		now:     time.Now,
+	}
+}
+
+// Allow returns true, if count of requests for key in the current window does not exceed limit
+func (l *FixedWindow) Allow(key string, limit int64, window time.Duration) (bool, error) {
+	if limit <= 0 || window <= 0 {
+		return false, ErrInvalidLimit
+	}
+
+	now := l.now().UTC().UnixNano()
+	index := now / int64(window)
+	name := variableName(l.prefix, key, window, index)
+
+	value := l.storage.Upsert(name, 1)
+
+	// first request in the window, as far as this node knows, set TTL to the end of window
+	// TTL is replicated with the counter, so nodes, which see greater value, get TTL with it
+	if value == 1 {
+		if err := l.storage.UpdateTTL(name, time.Unix(0, (index+1)*int64(window)).UTC()); err != nil {
+			return false, err
+		}
+	}
+
+	if value > limit {
+		l.storage.Upsert(name, -1)
+		return false, nil
+	}
+
+	return true, nil
+}
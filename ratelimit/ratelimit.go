@@ -0,0 +1,57 @@
+// Package ratelimit implements cluster-wide rate limiters on top of rplx counters
+//
+// Limiters store counters in rplx variables with names, based on limiter prefix, key and window,
+// and set TTL for variables automatically, so variables are removed by rplx GC after the window is over.
+//
+// # Over-admission
+//
+// Rplx replicates counters asynchronously, each node sees admissions made on other nodes
+// with delay up to RemoteNodeOption.SyncInterval (plus network latency).
+// While replicated data is not received, each node decides with its local view only.
+// For cluster of N nodes, where each node admits requests with rate R (requests per second),
+// limiters admit up to (N - 1) * R * SyncInterval requests over the limit per window.
+// Worst case, if all nodes get requests in the same SyncInterval after long silence,
+// is N * limit requests per window.
+// Use SyncInterval much less than the limiter window for better accuracy.
+// TokenBucket has own bound for concurrent calls, see TokenBucket.
+package ratelimit
+
+import (
+	"github.com/negasus/rplx"
+	"github.com/pkg/errors"
+	"strconv"
+	"time"
+)
+
+var (
+	// ErrInvalidLimit returns if limit or window is not positive
+	ErrInvalidLimit = errors.New("limit and window must be positive")
+)
+
+// Storage describe counters storage, implemented by *rplx.Rplx
+type Storage interface {
+	Get(name string) (int64, error)
+	Upsert(name string, delta int64) int64
+	UpdateTTL(name string, ttl time.Time) error
+}
+
+// Limiter describe rate limiter
+type Limiter interface {
+	// Allow returns true, if request for key is allowed with limit requests per window
+	Allow(key string, limit int64, window time.Duration) (bool, error)
+}
+
+// variableName returns rplx variable name for key, window and window index
+func variableName(prefix, key string, window time.Duration, index int64) string {
+	return prefix + key + ":" + strconv.FormatInt(int64(window), 10) + ":" + strconv.FormatInt(index, 10)
+}
+
+// getValue returns variable value or 0, if variable not exists or expired
+func getValue(storage Storage, name string) (int64, error) {
+	value, err := storage.Get(name)
+	if err == rplx.ErrVariableNotExists || err == rplx.ErrVariableExpired {
+		return 0, nil
+	}
+
+	return value, err
+}
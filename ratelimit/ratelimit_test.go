@@ -0,0 +1,174 @@
+package ratelimit
+
+import (
+	"github.com/negasus/rplx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type clock struct {
+	t time.Time
+}
+
+func (c *clock) now() time.Time {
+	return c.t
+}
+
+func (c *clock) add(d time.Duration) {
+	c.t = c.t.Add(d)
+}
+
+func newClock() *clock {
+	// clock in the future, so variables TTL are not expired
+	return &clock{t: time.Now().UTC().Truncate(time.Hour).Add(time.Hour * 2)}
+}
+
+func allowN(t *testing.T, l Limiter, n int, limit int64, window time.Duration) int {
+	t.Helper()
+
+	allowed := 0
+
+	for i := 0; i < n; i++ {
+		ok, err := l.Allow("key", limit, window)
+		require.NoError(t, err)
+		if ok {
+			allowed++
+		}
+	}
+
+	return allowed
+}
+
+func TestLimiters_InvalidLimit(t *testing.T) {
+	r := rplx.New()
+
+	for _, l := range []Limiter{NewFixedWindow(r, "f:"), NewSlidingWindow(r, "s:"), NewTokenBucket(r, "t:")} {
+		_, err := l.Allow("key", 0, time.Second)
+		assert.Equal(t, ErrInvalidLimit, err)
+
+		_, err = l.Allow("key", 1, 0)
+		assert.Equal(t, ErrInvalidLimit, err)
+	}
+}
+
+func TestFixedWindow_Allow(t *testing.T) {
+	c := newClock()
+
+	l := NewFixedWindow(rplx.New(), "f:")
+	l.now = c.now
+
+	assert.Equal(t, 10, allowN(t, l, 15, 10, time.Minute))
+
+	c.add(time.Minute)
+
+	assert.Equal(t, 10, allowN(t, l, 15, 10, time.Minute))
+}
+
+func TestFixedWindow_TTL(t *testing.T) {
+	r := rplx.New()
+
+	l := NewFixedWindow(r, "f:")
+	l.now = func() time.Time { return time.Now().Add(-time.Hour) }
+
+	ok, err := l.Allow("key", 10, time.Minute)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, expired := r.All()
+	assert.Len(t, expired, 1)
+}
+
+func TestSlidingWindow_Allow(t *testing.T) {
+	c := newClock()
+
+	l := NewSlidingWindow(rplx.New(), "s:")
+	l.now = c.now
+
+	assert.Equal(t, 10, allowN(t, l, 15, 10, time.Minute))
+
+	// previous window counter has weight 0.5
+	c.add(time.Minute + time.Second*30)
+	assert.Equal(t, 5, allowN(t, l, 15, 10, time.Minute))
+
+	// previous window counter has weight 0.25, current window counter is 5
+	c.add(time.Second * 15)
+	assert.Equal(t, 3, allowN(t, l, 15, 10, time.Minute))
+}
+
+func TestTokenBucket_Allow(t *testing.T) {
+	c := newClock()
+
+	l := NewTokenBucket(rplx.New(), "t:")
+	l.now = c.now
+
+	// burst
+	assert.Equal(t, 10, allowN(t, l, 15, 10, time.Minute))
+
+	// one token per 6 seconds
+	c.add(time.Second * 6)
+	assert.Equal(t, 1, allowN(t, l, 5, 10, time.Minute))
+
+	c.add(time.Second * 30)
+	assert.Equal(t, 5, allowN(t, l, 10, 10, time.Minute))
+}
+
+func TestTokenBucket_CarryToNextWindow(t *testing.T) {
+	c := newClock()
+	c.add(time.Second * 50)
+
+	l := NewTokenBucket(rplx.New(), "t:")
+	l.now = c.now
+
+	assert.Equal(t, 10, allowN(t, l, 15, 10, time.Minute))
+
+	// next window, but bucket refilled only for 2 tokens
+	c.add(time.Second * 12)
+	assert.Equal(t, 2, allowN(t, l, 15, 10, time.Minute))
+
+	// idle bucket is full
+	c.add(time.Minute * 5)
+	assert.Equal(t, 10, allowN(t, l, 15, 10, time.Minute))
+}
+
+// staleStorage returns values of the variables, which are not replicated to the node yet
+type staleStorage struct {
+	Storage
+}
+
+func (s *staleStorage) Get(name string) (int64, error) {
+	return 0, rplx.ErrVariableNotExists
+}
+
+func TestTokenBucket_ConcurrentIdleMove(t *testing.T) {
+	c := newClock()
+	c.add(time.Second * 50)
+
+	r := rplx.New()
+
+	l := NewTokenBucket(r, "t:")
+	l.now = c.now
+
+	assert.Equal(t, 1, allowN(t, l, 1, 10, time.Minute))
+
+	// two more nodes move idle bucket TAT with stale view
+	for i := 0; i < 2; i++ {
+		stale := NewTokenBucket(&staleStorage{Storage: r}, "t:")
+		stale.now = c.now
+
+		ok, err := stale.Allow("key", 10, time.Minute)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	}
+
+	// bucket starves until moved TAT in the next window
+	assert.Equal(t, 0, allowN(t, l, 15, 10, time.Minute))
+	c.add(time.Second * 15)
+	assert.Equal(t, 0, allowN(t, l, 15, 10, time.Minute))
+	c.add(time.Second * 40)
+	assert.Equal(t, 1, allowN(t, l, 15, 10, time.Minute))
+
+	c.add(time.Minute)
+	assert.Equal(t, 10, allowN(t, l, 15, 10, time.Minute))
+}
@@ -0,0 +1,60 @@
+package ratelimit
+
+import (
+	"time"
+)
+
+// SlidingWindow limiter approximates count of requests in the last window
+// with counters of the current and the previous fixed windows:
+// estimate = previous * (part of the previous window, covered by sliding window) + current
+type SlidingWindow struct {
+	storage Storage
+	prefix  string
+	now     func() time.Time
+}
+
+// NewSlidingWindow creates SlidingWindow limiter, prefix is used for rplx variables names
+func NewSlidingWindow(storage Storage, prefix string) *SlidingWindow {
+	return &SlidingWindow{
+		storage: storage,
+		prefix:  prefix,
+		now:     time.Now,
+	}
+}
+
+// Allow returns true, if estimated count of requests for key in the last window does not exceed limit
+func (l *SlidingWindow) Allow(key string, limit int64, window time.Duration) (bool, error) {
+	if limit <= 0 || window <= 0 {
+		return false, ErrInvalidLimit
+	}
+
+	now := l.now().UTC().UnixNano()
+	index := now / int64(window)
+	elapsed := now - index*int64(window)
+
+	previous, err := getValue(l.storage, variableName(l.prefix, key, window, index-1))
+	if err != nil {
+		return false, err
+	}
+
+	name := variableName(l.prefix, key, window, index)
+
+	current := l.storage.Upsert(name, 1)
+
+	// first request in the window, as far as this node knows, variable is needed while next window is active
+	// TTL is replicated with the counter, so nodes, which see greater value, get TTL with it
+	if current == 1 {
+		if err := l.storage.UpdateTTL(name, time.Unix(0, (index+2)*int64(window)).UTC()); err != nil {
+			return false, err
+		}
+	}
+
+	weight := float64(int64(window)-elapsed) / float64(window)
+
+	if int64(float64(previous)*weight)+current > limit {
+		l.storage.Upsert(name, -1)
+		return false, nil
+	}
+
+	return true, nil
+}
@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"time"
+)
+
+// TokenBucket limiter allows bursts up to limit requests and refills limit tokens per window
+//
+// Implemented as GCRA (generic cell rate algorithm). Counter stores theoretical arrival time (TAT)
+// for the next request relative to the window start. Each window has own counter,
+// TAT of the previous window, which exceeds the window end, carries over to the next window.
+//
+// Each call adds own move of TAT to the counter, computed from the local view of the counter.
+// If k calls (on one node or on several nodes within SyncInterval) move idle bucket TAT
+// to the current time concurrently, idle time is added k times and TAT is moved up to k times further than needed.
+// Then bucket admits no requests until the moved TAT, at most until the end of the next window,
+// because TAT is carried over one window only.
+// This starvation is the bound of TokenBucket for concurrent calls, instead of over-admission.
+type TokenBucket struct {
+	storage Storage
+	prefix  string
+	now     func() time.Time
+}
+
+// NewTokenBucket creates TokenBucket limiter, prefix is used for rplx variables names
+func NewTokenBucket(storage Storage, prefix string) *TokenBucket {
+	return &TokenBucket{
+		storage: storage,
+		prefix:  prefix,
+		now:     time.Now,
+	}
+}
+
+// Allow returns true, if bucket for key has a token
+func (l *TokenBucket) Allow(key string, limit int64, window time.Duration) (bool, error) {
+	if limit <= 0 || window <= 0 {
+		return false, ErrInvalidLimit
+	}
+
+	w := int64(window)
+
+	interval := w / limit
+	if interval == 0 {
+		interval = 1
+	}
+
+	now := l.now().UTC().UnixNano()
+	index := now / w
+	elapsed := now - index*w
+
+	previous, err := getValue(l.storage, variableName(l.prefix, key, window, index-1))
+	if err != nil {
+		return false, err
+	}
+
+	name := variableName(l.prefix, key, window, index)
+
+	stored, err := getValue(l.storage, name)
+	if err != nil {
+		return false, err
+	}
+
+	tat := stored
+	// previous window TAT, relative to the current window start
+	if carry := previous - w; carry > tat {
+		tat = carry
+	}
+	if elapsed > tat {
+		tat = elapsed
+	}
+
+	if tat+interval-elapsed > w {
+		return false, nil
+	}
+
+	value := l.storage.Upsert(name, tat+interval-stored)
+
+	// counter is needed while next window is active
+	if stored == 0 {
+		if err := l.storage.UpdateTTL(name, time.Unix(0, (index+2)*w).UTC()); err != nil {
+			return false, err
+		}
+	}
+
+	// TAT was moved by concurrent calls
+	if value-elapsed > w {
+		l.storage.Upsert(name, -interval)
+		return false, nil
+	}
+
+	return true, nil
+}
@@ -37,14 +37,50 @@ type Rplx struct {
 	variablesMx sync.RWMutex
 	variables   map[string]*variable
 
+	// senders contains mutexes, which serialize applying of sync requests per sender node
+	sendersMx sync.Mutex
+	senders   map[string]*sync.Mutex
+
 	gcInterval time.Duration
 
+	// expiry contains variables with TTL, ordered by TTL
+	expiryMx sync.Mutex
+	expiry   *expiryQueue
+
 	remoteNodesTicker        *time.Ticker
 	remoteNodesProvider      RemoteNodesProvider
 	remoteNodesCheckInterval time.Duration
 	grpcServer               *grpc.Server
 
-	gcTicker *time.Ticker
+	gcWakeup chan struct{}
+	gcStop   chan struct{}
+
+	// compactable contains variables, which data is compacted by GC
+	compactableMx sync.Mutex
+	compactable   map[string]*variable
+
+	watchersMx      sync.RWMutex
+	watchers        map[uint64]*watcher
+	watchersSeq     uint64
+	watchBufferSize int
+
+	triggersMx  sync.RWMutex
+	triggers    map[string]map[uint64]*trigger
+	triggersSeq uint64
+
+	// customTypes contains registered user-defined types, map key - type name
+	customTypes map[string]CustomType
+
+	// sequenceSettle - time after slot claim, before node issues IDs of Sequence variable
+	sequenceSettle time.Duration
+
+	// applied contains channels of GetAfter waiters, channel is closed, when variable is changed by replication
+	appliedMx sync.Mutex
+	applied   map[string]*appliedWaiters
+
+	// tombstones contains TTL of removed variables, returned by Fetch, map key - variable name
+	tombstonesMx sync.Mutex
+	tombstones   map[string]*tombstone
 
 	readOnly int32
 
@@ -57,10 +93,22 @@ func New(opts ...Option) *Rplx {
 	r := &Rplx{
 		logger:                   defaultLogger,
 		variables:                make(map[string]*variable),
+		senders:                  make(map[string]*sync.Mutex),
 		replicationChan:          make(chan *variable, defaultReplicationChanCap),
 		nodes:                    make(map[string]*node),
 		nodesIDToAddr:            make(map[string]string),
 		gcInterval:               defaultGCInterval,
+		expiry:                   newExpiryQueue(),
+		gcWakeup:                 make(chan struct{}, 1),
+		gcStop:                   make(chan struct{}),
+		compactable:              make(map[string]*variable),
+		watchers:                 make(map[uint64]*watcher),
+		watchBufferSize:          defaultWatchBufferSize,
+		triggers:                 make(map[string]map[uint64]*trigger),
+		customTypes:              make(map[string]CustomType),
+		sequenceSettle:           defaultSequenceSettle,
+		applied:                  make(map[string]*appliedWaiters),
+		tombstones:               make(map[string]*tombstone),
 		remoteNodesCheckInterval: defaultRemoteNodesCheckInterval,
 	}
 
@@ -104,13 +152,14 @@ func (rplx *Rplx) Stop() {
 		rplx.remoteNodesTicker.Stop()
 	}
 
-	if rplx.gcTicker != nil {
-		rplx.gcTicker.Stop()
-	}
+	close(rplx.gcStop)
 
 	for _, n := range rplx.nodes {
 		n.Stop()
 	}
+
+	rplx.stopWatchers()
+	rplx.stopTriggers()
 }
 
 // StartReplicationServer starts grpc server for receive sync messages from remote nodes
@@ -210,40 +259,166 @@ func (rplx *Rplx) listenReplicationChannel() {
 }
 
 // startGC start GC loop
+// loop wakes up at the nearest variable TTL, but not later than gcInterval
+// variables compaction runs every gcInterval
 func (rplx *Rplx) startGC() {
 	rplx.logger.Debug("start GC loop", zap.Duration("interval", rplx.gcInterval))
 
-	rplx.gcTicker = time.NewTicker(rplx.gcInterval)
+	timer := time.NewTimer(rplx.nextGCDelay())
+	defer timer.Stop()
+
+	// compaction scans all compactable variables, so it runs by own ticker, not on each expiry wakeup
+	compactTicker := time.NewTicker(rplx.gcInterval)
+	defer compactTicker.Stop()
+
+	for {
+		select {
+		case <-rplx.gcStop:
+			return
+		case <-compactTicker.C:
+			rplx.compact(time.Now().UTC().UnixNano())
+			continue
+		case <-rplx.gcWakeup:
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+		case <-timer.C:
+			rplx.gc()
+		}
 
-	for range rplx.gcTicker.C {
-		rplx.gc()
+		timer.Reset(rplx.nextGCDelay())
 	}
 }
 
-// gc collects expired variables and remove it from rplx.variable map
-func (rplx *Rplx) gc() {
-	namesToDelete := make([]string, 0)
+// nextGCDelay returns duration to the nearest variable TTL, limited by gcInterval
+func (rplx *Rplx) nextGCDelay() time.Duration {
+	rplx.expiryMx.Lock()
+	ttl, ok := rplx.expiry.next()
+	rplx.expiryMx.Unlock()
 
-	now := time.Now().UTC().UnixNano()
+	if !ok {
+		return rplx.gcInterval
+	}
 
-	rplx.variablesMx.RLock()
+	delay := time.Duration(ttl - time.Now().UTC().UnixNano())
+	if delay < 0 {
+		return 0
+	}
 
-	for name, v := range rplx.variables {
-		if v.ttl > 0 && v.ttl < now {
-			namesToDelete = append(namesToDelete, name)
+	if delay > rplx.gcInterval {
+		return rplx.gcInterval
+	}
+
+	return delay
+}
+
+// scheduleExpiry puts variable to the expiry queue or removes it from the queue, if ttl is 0
+// wakes up GC loop, if variable became the nearest to expire
+func (rplx *Rplx) scheduleExpiry(name string, ttl int64) {
+	if rplx.expiry == nil {
+		return
+	}
+
+	rplx.expiryMx.Lock()
+	if ttl <= 0 {
+		rplx.expiry.remove(name)
+		rplx.expiryMx.Unlock()
+		return
+	}
+	first := rplx.expiry.set(name, ttl)
+	rplx.expiryMx.Unlock()
+
+	if first {
+		select {
+		case rplx.gcWakeup <- struct{}{}:
+		default:
 		}
 	}
-	rplx.variablesMx.RUnlock()
+}
+
+// registerCompactable puts variable to GC compaction list, if variable kind requires compaction
+func (rplx *Rplx) registerCompactable(v *variable) {
+	if rplx.compactable == nil || !v.compactable() {
+		return
+	}
+
+	rplx.compactableMx.Lock()
+	rplx.compactable[v.name] = v
+	rplx.compactableMx.Unlock()
+}
+
+// compact compacts registered variables data and sends changed variables to replication
+// removed variables are removed from compaction list
+func (rplx *Rplx) compact(now int64) {
+	rplx.compactableMx.Lock()
+	list := make([]*variable, 0, len(rplx.compactable))
+	for _, v := range rplx.compactable {
+		list = append(list, v)
+	}
+	rplx.compactableMx.Unlock()
+
+	for _, v := range list {
+		rplx.variablesMx.RLock()
+		current, ok := rplx.variables[v.name]
+		rplx.variablesMx.RUnlock()
+
+		if !ok || current != v {
+			rplx.compactableMx.Lock()
+			if rplx.compactable[v.name] == v {
+				delete(rplx.compactable, v.name)
+			}
+			rplx.compactableMx.Unlock()
+			continue
+		}
+
+		if v.compact(rplx.nodeID, now) {
+			go rplx.sendToReplication(v)
+		}
+	}
+}
+
+// gc collects expired variables from the expiry queue and remove it from rplx.variable map
+func (rplx *Rplx) gc() {
+	now := time.Now().UTC().UnixNano()
+
+	rplx.pruneTombstones(now)
+
+	rplx.expiryMx.Lock()
+	names := rplx.expiry.popExpired(now)
+	rplx.expiryMx.Unlock()
+
+	if len(names) == 0 {
+		return
+	}
+
+	namesToDelete := make([]string, 0, len(names))
+	events := make([]Event, 0)
 
 	rplx.variablesMx.Lock()
-	for _, name := range namesToDelete {
+	for _, name := range names {
 		v, ok := rplx.variables[name]
-		if ok && v.ttl < time.Now().UTC().UnixNano() {
+		// TTL may be changed after variable was taken from the queue
+		if ok && v.TTL() > 0 && v.TTL() < now {
 			delete(rplx.variables, name)
+			rplx.addTombstone(v)
+			namesToDelete = append(namesToDelete, name)
+
+			if rplx.hasWatchers(name) {
+				events = append(events, rplx.localEvent(EventExpire, v))
+			}
 		}
 	}
 	rplx.variablesMx.Unlock()
 
+	rplx.notify(events...)
+
+	for _, name := range namesToDelete {
+		rplx.checkTriggers(name, nil, false)
+	}
+
 	if len(namesToDelete) > 0 {
 		rplx.logger.Debug("gc collect variables", zap.Int("count", len(namesToDelete)), zap.Strings("names", namesToDelete))
 	}
@@ -2,6 +2,7 @@ package rplx
 
 import (
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 	"time"
 )
 
@@ -10,50 +11,101 @@ var (
 	ErrVariableNotExists = errors.New("variable not exists")
 	// ErrVariableExpired returns if variable is expired
 	ErrVariableExpired = errors.New("variable expired")
+	// ErrVariableKind returns if variable has another kind
+	ErrVariableKind = errors.New("variable has another kind")
+	// ErrInvalidWindow returns if window or bucket size is not valid
+	ErrInvalidWindow = errors.New("invalid window or bucket size")
+	// ErrInvalidPeriod returns if period length or zone offset is not valid
+	ErrInvalidPeriod = errors.New("invalid period length or zone offset")
+	// ErrInvalidValue returns if value is NaN or infinity
+	ErrInvalidValue = errors.New("invalid value")
+	// ErrInvalidAmount returns if amount is not positive
+	ErrInvalidAmount = errors.New("amount must be positive")
+	// ErrInvalidQuantile returns if quantile is not in range [0, 1]
+	ErrInvalidQuantile = errors.New("quantile must be in range [0, 1]")
+	// ErrOverflow returns if counter value overflows int64 and overflow policy does not allow it
+	ErrOverflow = errors.New("counter overflow")
+	// ErrInvalidHalfLife returns if half-life is not positive
+	ErrInvalidHalfLife = errors.New("half-life must be positive")
+	// ErrUnknownType returns if custom type is not registered
+	ErrUnknownType = errors.New("unknown custom type")
+	// ErrNoFreeSlots returns if all slots of Sequence variable are reserved by other nodes
+	ErrNoFreeSlots = errors.New("no free sequence slots")
+	// ErrInvalidToken returns if session token can not be parsed
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrTimeout returns if variable is not replicated in time
+	ErrTimeout = errors.New("timeout")
+	// ErrFieldNotExists returns if hash field not exists
+	ErrFieldNotExists = errors.New("field not exists")
+	// ErrNotEnoughPeers returns if less than required count of remote nodes are connected
+	ErrNotEnoughPeers = errors.New("not enough connected nodes")
 )
 
 // Get returns variable v or error if variable not exists or expired
 // if variable expired, removes variable from rplx.variables map
+// returns ErrVariableKind, if variable kind has not int64 value
 func (rplx *Rplx) Get(name string) (int64, error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return 0, err
+	}
+
+	if !v.numeric() {
+		return 0, ErrVariableKind
+	}
+
+	return rplx.checkedValue(v)
+}
+
+// load returns variable or error if variable not exists or expired
+// if variable expired, removes variable from rplx.variables map
+func (rplx *Rplx) load(name string) (*variable, error) {
 	rplx.variablesMx.RLock()
 	v, ok := rplx.variables[name]
 	rplx.variablesMx.RUnlock()
 
 	if !ok {
-		return 0, ErrVariableNotExists
+		return nil, ErrVariableNotExists
 	}
 
 	ttl := v.TTL()
 
 	if ttl > 0 && ttl < time.Now().UTC().UnixNano() {
-		rplx.variablesMx.Lock()
-		delete(rplx.variables, name)
-		rplx.variablesMx.Unlock()
+		rplx.removeExpired(name, v)
 
-		return 0, ErrVariableExpired
+		return nil, ErrVariableExpired
 	}
 
-	return v.get(), nil
+	return v, nil
 }
 
-// VariablePartsCount returns count remote nodes parts for variable
-func (rplx *Rplx) VariablePartsCount(name string) (int, error) {
-	rplx.variablesMx.RLock()
-	v, ok := rplx.variables[name]
-	rplx.variablesMx.RUnlock()
-
-	if !ok {
-		return 0, ErrVariableNotExists
+// removeExpired removes expired variable from rplx.variables map
+func (rplx *Rplx) removeExpired(name string, v *variable) {
+	rplx.variablesMx.Lock()
+	// variable may be replaced or TTL may be changed while lock was released
+	current, ok := rplx.variables[name]
+	if !ok || current != v || v.TTL() == 0 || v.TTL() >= time.Now().UTC().UnixNano() {
+		rplx.variablesMx.Unlock()
+		return
 	}
+	delete(rplx.variables, name)
+	rplx.addTombstone(v)
+	rplx.variablesMx.Unlock()
 
-	ttl := v.TTL()
+	rplx.scheduleExpiry(name, 0)
 
-	if ttl > 0 && ttl < time.Now().UTC().UnixNano() {
-		rplx.variablesMx.Lock()
-		delete(rplx.variables, name)
-		rplx.variablesMx.Unlock()
+	if rplx.hasWatchers(name) {
+		rplx.notify(rplx.localEvent(EventExpire, v))
+	}
 
-		return 0, ErrVariableExpired
+	rplx.checkTriggers(name, nil, false)
+}
+
+// VariablePartsCount returns count remote nodes parts for variable
+func (rplx *Rplx) VariablePartsCount(name string) (int, error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return 0, err
 	}
 
 	return v.partsCount(), nil
@@ -75,6 +127,15 @@ func (rplx *Rplx) Delete(name string) error {
 	go rplx.sendToReplication(v)
 
 	delete(rplx.variables, name)
+	rplx.addTombstone(v)
+
+	rplx.scheduleExpiry(name, 0)
+
+	if rplx.hasWatchers(name) {
+		rplx.notify(rplx.localEvent(EventDelete, v))
+	}
+
+	rplx.checkTriggers(name, nil, false)
 
 	return nil
 }
@@ -91,45 +152,112 @@ func (rplx *Rplx) UpdateTTL(name string, ttl time.Time) error {
 
 	v.updateTTL(ttl.UnixNano())
 
+	rplx.scheduleExpiry(name, ttl.UnixNano())
+
 	go rplx.sendToReplication(v)
 
+	if rplx.hasWatchers(name) {
+		rplx.notify(rplx.localEvent(EventTTL, v))
+	}
+
 	return nil
 }
 
 // Upsert change variable on delta or create variable, if not exists
+// for variable of Window kind, delta is added to the current bucket
+// for variable of Period kind, delta is added to the current period
 // returns new value
 func (rplx *Rplx) Upsert(name string, delta int64) int64 {
-	var v *variable
-	var ok bool
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newVariable(name)
+	})
+
+	value, err := rplx.upsert(v, delta)
+	if err != nil {
+		rplx.logger.Error("error upsert variable", zap.String("name", name), zap.String("kind", v.kind.String()), zap.Error(err))
+	}
+
+	return value
+}
 
+// loadOrCreate returns variable by name or creates new variable with create func, if variable not exists
+func (rplx *Rplx) loadOrCreate(name string, create func() *variable) *variable {
 	rplx.variablesMx.RLock()
-	v, ok = rplx.variables[name]
+	v, ok := rplx.variables[name]
 	rplx.variablesMx.RUnlock()
+
+	if ok {
+		return v
+	}
+
+	rplx.variablesMx.Lock()
+	v, ok = rplx.variables[name]
+	if !ok {
+		v = create()
+		rplx.variables[name] = v
+	}
+	rplx.variablesMx.Unlock()
+
 	if !ok {
-		rplx.variablesMx.Lock()
-		v, ok = rplx.variables[name]
-		if !ok {
-			v = newVariable(name)
-			rplx.variables[name] = v
+		rplx.registerCompactable(v)
+	}
+
+	return v
+}
+
+// upsert changes variable on delta, returns new value
+func (rplx *Rplx) upsert(v *variable, delta int64) (int64, error) {
+	if !v.additive() {
+		return 0, ErrVariableKind
+	}
+
+	if rplx.resetExpired(v) {
+		if v.kind == Kind_Counter {
+			delta = delta - v.get()
+		} else {
+			v.resetParts()
 		}
-		rplx.variablesMx.Unlock()
 	}
 
+	overflow, err := v.add(delta)
+	if overflow {
+		rplx.countOverflow("update")
+	}
+	if err != nil {
+		return v.get(), err
+	}
+
+	rplx.localUpdated(v, EventUpsert)
+
+	return rplx.checkedValue(v)
+}
+
+// resetExpired resets TTL of expired, but not garbage collected variable, for reuse variable
+// returns true, if variable was expired
+func (rplx *Rplx) resetExpired(v *variable) bool {
 	ttl := v.TTL()
-	// if variable has TTL and TTL less than Now, variable was expired, but not garbage collected
-	if ttl > 0 && ttl < time.Now().UTC().UnixNano() {
-		v.updateTTL(0)
-		delta = delta - v.get()
+	if ttl == 0 || ttl >= time.Now().UTC().UnixNano() {
+		return false
 	}
 
-	v.update(delta)
+	v.updateTTL(0)
+	rplx.scheduleExpiry(v.name, 0)
+
+	return true
+}
 
+// localUpdated sends variable, changed by local call, to replication, notifies watchers and checks triggers
+func (rplx *Rplx) localUpdated(v *variable, t EventType) {
 	go rplx.sendToReplication(v)
 
-	return v.get()
+	if rplx.hasWatchers(v.name) {
+		rplx.notify(rplx.localEvent(t, v))
+	}
+
+	rplx.checkTriggers(v.name, v, false)
 }
 
-// All returns all variables values
+// All returns all variables values, variables with not int64 value are skipped
 // first returns param - not expires variables
 // second param - expires, but not garbage collected variables
 func (rplx *Rplx) All() (notExpired map[string]int64, expired map[string]int64) {
@@ -140,6 +268,10 @@ func (rplx *Rplx) All() (notExpired map[string]int64, expired map[string]int64)
 	defer rplx.variablesMx.RUnlock()
 
 	for name, v := range rplx.variables {
+		if !v.numeric() {
+			continue
+		}
+
 		ttl := v.TTL()
 
 		if ttl > 0 && ttl < time.Now().UTC().UnixNano() {
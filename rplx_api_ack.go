@@ -0,0 +1,59 @@
+package rplx
+
+import (
+	"context"
+)
+
+// UpsertAck changes variable on delta like Upsert and waits until at least minPeers connected nodes acknowledged the change
+// acknowledgement is successful Sync response, remote node applies received data asynchronously,
+// so the change may be not visible on acknowledged node right after UpsertAck returns
+// returns new value and IDs of nodes, which acknowledged the change in order of acknowledgement
+// if less than minPeers nodes are connected, returns ErrNotEnoughPeers without change
+// if ctx is done before minPeers acknowledgements, returns ctx.Err() with nodes, acknowledged the change before
+func (rplx *Rplx) UpsertAck(ctx context.Context, name string, delta int64, minPeers int) (int64, []string, error) {
+	nodes := rplx.connectedNodes()
+	if minPeers > len(nodes) {
+		return 0, nil, ErrNotEnoughPeers
+	}
+
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newVariable(name)
+	})
+
+	value, err := rplx.upsert(v, delta)
+	if err != nil {
+		return value, nil, err
+	}
+
+	confirmed := make([]string, 0, minPeers)
+
+	if minPeers <= 0 {
+		return value, confirmed, nil
+	}
+
+	version := v.nodeVersion(rplx.nodeID, rplx.nodeID)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	acks := make(chan string, len(nodes))
+
+	for _, n := range nodes {
+		go func(n *node) {
+			if n.waitAck(ctx, v, version) {
+				acks <- n.remoteNodeID
+			}
+		}(n)
+	}
+
+	for len(confirmed) < minPeers {
+		select {
+		case <-ctx.Done():
+			return value, confirmed, ctx.Err()
+		case nodeID := <-acks:
+			confirmed = append(confirmed, nodeID)
+		}
+	}
+
+	return value, confirmed, nil
+}
@@ -0,0 +1,43 @@
+package rplx
+
+// UpsertAverage adds sum of count samples to variable of Average kind or creates variable, if not exists
+// for single sample use count 1, returns mean of all nodes samples
+func (rplx *Rplx) UpsertAverage(name string, sum int64, count int64) (int64, error) {
+	if count <= 0 {
+		return 0, ErrInvalidAmount
+	}
+
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newAverageVariable(name)
+	})
+
+	if v.kind != Kind_Average {
+		return 0, ErrVariableKind
+	}
+
+	if rplx.resetExpired(v) {
+		v.resetParts()
+	}
+
+	v.averageAdd(sum, count)
+
+	rplx.localUpdated(v, EventUpsert)
+
+	return v.averageMean(), nil
+}
+
+// GetParts returns sum and count of all nodes samples of variable of Average kind
+func (rplx *Rplx) GetParts(name string) (sum int64, count int64, err error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if v.kind != Kind_Average {
+		return 0, 0, ErrVariableKind
+	}
+
+	sum, count = v.averageParts()
+
+	return sum, count, nil
+}
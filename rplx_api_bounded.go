@@ -0,0 +1,116 @@
+package rplx
+
+import (
+	"context"
+)
+
+// BoundedIncrement increments variable of Bounded kind on delta or creates variable, if not exists
+// increment adds rights for decrements to the local node
+// returns new variable value
+func (rplx *Rplx) BoundedIncrement(name string, delta int64) (int64, error) {
+	if delta <= 0 {
+		return 0, ErrInvalidAmount
+	}
+
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newBoundedVariable(name)
+	})
+
+	if v.kind != Kind_Bounded {
+		return 0, ErrVariableKind
+	}
+
+	if rplx.resetExpired(v) {
+		v.resetParts()
+	}
+
+	v.boundedIncrement(delta)
+
+	rplx.localUpdated(v, EventUpsert)
+
+	return v.get(), nil
+}
+
+// TryDecrement decrements variable of Bounded kind on delta, if local node has enough rights
+// returns false, if local rights are exhausted, use RequestRights for get rights from remote nodes
+func (rplx *Rplx) TryDecrement(name string, delta int64) (bool, error) {
+	if delta <= 0 {
+		return false, ErrInvalidAmount
+	}
+
+	v, err := rplx.load(name)
+	if err != nil {
+		return false, err
+	}
+
+	if v.kind != Kind_Bounded {
+		return false, ErrVariableKind
+	}
+
+	if !v.tryDecrement(rplx.nodeID, delta) {
+		return false, nil
+	}
+
+	rplx.localUpdated(v, EventUpsert)
+
+	return true, nil
+}
+
+// LocalRights returns rights of local node for decrements of variable of Bounded kind
+func (rplx *Rplx) LocalRights(name string) (int64, error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return 0, err
+	}
+
+	if v.kind != Kind_Bounded {
+		return 0, ErrVariableKind
+	}
+
+	return v.boundedRights(rplx.nodeID), nil
+}
+
+// RequestRights requests up to amount rights for variable of Bounded kind from connected remote nodes
+// each remote node grants up to half of own rights, returns received rights
+func (rplx *Rplx) RequestRights(ctx context.Context, name string, amount int64) (int64, error) {
+	if amount <= 0 {
+		return 0, ErrInvalidAmount
+	}
+
+	v, err := rplx.load(name)
+	if err != nil {
+		return 0, err
+	}
+
+	if v.kind != Kind_Bounded {
+		return 0, ErrVariableKind
+	}
+
+	var received int64
+
+	for _, n := range rplx.connectedNodes() {
+		if received >= amount {
+			break
+		}
+
+		resp, err := n.requestRights(ctx, name, amount-received)
+		if err != nil {
+			if ctx.Err() != nil {
+				return received, ctx.Err()
+			}
+			continue
+		}
+
+		if resp.Granted > 0 && resp.Value != nil {
+			v.updatePart(n.remoteNodeID, resp.Value)
+			received += resp.Granted
+		}
+	}
+
+	// granted parts are replicated to other nodes
+	if received > 0 {
+		go rplx.sendToReplication(v)
+	}
+
+	return received, nil
+}
@@ -0,0 +1,61 @@
+package rplx
+
+// Incr adds positive delta to item count in variable of CountMin kind or creates variable, if not exists
+// returns estimated count of item on all nodes
+func (rplx *Rplx) Incr(name string, item string, delta int64) (int64, error) {
+	if delta <= 0 {
+		return 0, ErrInvalidAmount
+	}
+
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newCountMinVariable(name)
+	})
+
+	if v.kind != Kind_CountMin {
+		return 0, ErrVariableKind
+	}
+
+	if rplx.resetExpired(v) {
+		v.resetParts()
+	}
+
+	v.countMinIncr(item, delta)
+
+	rplx.localUpdated(v, EventUpsert)
+
+	return v.countMinEstimate(item), nil
+}
+
+// Estimate returns estimated count of item in variable of CountMin kind on all nodes
+// estimate is never less than real count
+func (rplx *Rplx) Estimate(name string, item string) (int64, error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return 0, err
+	}
+
+	if v.kind != Kind_CountMin {
+		return 0, ErrVariableKind
+	}
+
+	return v.countMinEstimate(item), nil
+}
+
+// TopK returns up to n items with the greatest estimated count in variable of CountMin kind, ordered by count
+// returns ErrInvalidAmount, if n is not positive
+func (rplx *Rplx) TopK(name string, n int) ([]TopKItem, error) {
+	if n <= 0 {
+		return nil, ErrInvalidAmount
+	}
+
+	v, err := rplx.load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.kind != Kind_CountMin {
+		return nil, ErrVariableKind
+	}
+
+	return v.countMinTopK(n), nil
+}
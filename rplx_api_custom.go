@@ -0,0 +1,59 @@
+package rplx
+
+// Mutate applies op to variable of registered custom type or creates variable, if not exists
+// returns error of CustomState.Mutate, if op is not applied
+func (rplx *Rplx) Mutate(name string, typeName string, op interface{}) error {
+	t, ok := rplx.customTypes[typeName]
+	if !ok {
+		return ErrUnknownType
+	}
+
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newCustomVariable(name, typeName, t)
+	})
+
+	if v.kind != Kind_Custom || v.customTypeName() != typeName {
+		return ErrVariableKind
+	}
+
+	if rplx.resetExpired(v) {
+		v.resetParts()
+	}
+
+	if err := v.customMutate(op); err != nil {
+		return err
+	}
+
+	rplx.localUpdated(v, EventUpsert)
+
+	return nil
+}
+
+// GetCustom returns value of variable of custom type, returned by CustomType.Value
+func (rplx *Rplx) GetCustom(name string) (interface{}, error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.kind != Kind_Custom {
+		return nil, ErrVariableKind
+	}
+
+	return v.customValue(), nil
+}
+
+// newSyncVariable creates variable for replicated data, variable of Custom kind is created with registered type
+// returns false, if variable kind, kind options or custom type are not valid
+func (rplx *Rplx) newSyncVariable(name string, sv *SyncVariable) (*variable, bool) {
+	if sv.Kind != Kind_Custom {
+		return newSyncVariable(name, sv)
+	}
+
+	t, ok := rplx.customTypes[sv.CustomType]
+	if !ok {
+		return nil, false
+	}
+
+	return newCustomVariable(name, sv.CustomType, t), true
+}
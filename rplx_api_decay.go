@@ -0,0 +1,51 @@
+package rplx
+
+import (
+	"math"
+	"time"
+)
+
+// UpsertDecay adds delta to variable of Decay kind or creates variable, if not exists
+// value of variable halves each halfLife, half-life is applied only on variable creation
+// returns variable value at the current time
+func (rplx *Rplx) UpsertDecay(name string, delta float64, halfLife time.Duration) (float64, error) {
+	if halfLife <= 0 {
+		return 0, ErrInvalidHalfLife
+	}
+
+	if math.IsNaN(delta) || math.IsInf(delta, 0) {
+		return 0, ErrInvalidValue
+	}
+
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newDecayVariable(name, int64(halfLife))
+	})
+
+	if v.kind != Kind_Decay {
+		return 0, ErrVariableKind
+	}
+
+	if rplx.resetExpired(v) {
+		v.resetParts()
+	}
+
+	v.updateDecay(delta)
+
+	rplx.localUpdated(v, EventUpsert)
+
+	return v.decaySum(time.Now().UTC().UnixNano()), nil
+}
+
+// GetDecay returns value of variable of Decay kind at the current time
+func (rplx *Rplx) GetDecay(name string) (float64, error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return 0, err
+	}
+
+	if v.kind != Kind_Decay {
+		return 0, ErrVariableKind
+	}
+
+	return v.decaySum(time.Now().UTC().UnixNano()), nil
+}
@@ -0,0 +1,35 @@
+package rplx
+
+// UpsertMax writes value to variable of Max kind or creates variable, if not exists
+// variable value is the greatest value, written on any node
+// returns new variable value
+func (rplx *Rplx) UpsertMax(name string, value int64) (int64, error) {
+	return rplx.upsertExtreme(name, value, Kind_Max)
+}
+
+// UpsertMin writes value to variable of Min kind or creates variable, if not exists
+// variable value is the least value, written on any node
+// returns new variable value
+func (rplx *Rplx) UpsertMin(name string, value int64) (int64, error) {
+	return rplx.upsertExtreme(name, value, Kind_Min)
+}
+
+func (rplx *Rplx) upsertExtreme(name string, value int64, kind Kind) (int64, error) {
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newExtremeVariable(name, kind)
+	})
+
+	if v.kind != kind {
+		return 0, ErrVariableKind
+	}
+
+	if rplx.resetExpired(v) {
+		v.resetParts()
+	}
+
+	v.updateExtreme(value)
+
+	rplx.localUpdated(v, EventUpsert)
+
+	return v.get(), nil
+}
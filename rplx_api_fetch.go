@@ -0,0 +1,56 @@
+package rplx
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"sync"
+)
+
+// GetFresh returns variable value like Get after merge with variable values of connected nodes
+// requests all connected nodes and waits responses until ctx is done, returns count of nodes, which responded
+func (rplx *Rplx) GetFresh(ctx context.Context, name string) (int64, int, error) {
+	nodes := rplx.connectedNodes()
+
+	var wg sync.WaitGroup
+	responses := make([]*FetchResponse, len(nodes))
+
+	for i, n := range nodes {
+		wg.Add(1)
+		go func(i int, n *node) {
+			defer wg.Done()
+
+			resp, err := n.fetch(ctx, name)
+			if err != nil {
+				rplx.logger.Debug("error fetch variable", zap.String("name", name), zap.String("remote node ID", n.remoteNodeID), zap.Error(err))
+				return
+			}
+
+			responses[i] = resp
+		}(i, n)
+	}
+
+	wg.Wait()
+
+	responded := 0
+
+	for i, resp := range responses {
+		if resp == nil {
+			continue
+		}
+
+		responded++
+
+		if resp.Code != fetchCodeOK || resp.Variable == nil {
+			continue
+		}
+
+		rplx.sync(&SyncRequest{
+			NodeID:    nodes[i].remoteNodeID,
+			Variables: map[string]*SyncVariable{name: resp.Variable},
+		})
+	}
+
+	value, err := rplx.Get(name)
+
+	return value, responded, err
+}
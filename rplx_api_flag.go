@@ -0,0 +1,58 @@
+package rplx
+
+import (
+	"time"
+)
+
+// EnableFlag enables flag or creates enabled flag with policy, if not exists
+// policy is applied only on variable creation
+// if ttl is greater than zero, enable expires after ttl and flag becomes disabled
+func (rplx *Rplx) EnableFlag(name string, policy FlagPolicy, ttl time.Duration) error {
+	var expire int64
+	if ttl > 0 {
+		expire = time.Now().UTC().Add(ttl).UnixNano()
+	}
+
+	return rplx.switchFlag(name, policy, true, expire)
+}
+
+// DisableFlag disables flag or creates disabled flag with policy, if not exists
+// policy is applied only on variable creation
+func (rplx *Rplx) DisableFlag(name string, policy FlagPolicy) error {
+	return rplx.switchFlag(name, policy, false, 0)
+}
+
+// FlagEnabled returns state of flag
+func (rplx *Rplx) FlagEnabled(name string) (bool, error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return false, err
+	}
+
+	if !v.flag() {
+		return false, ErrVariableKind
+	}
+
+	return v.flagEnabled(rplx.nodeID), nil
+}
+
+// switchFlag enables or disables flag, creates flag with policy, if not exists
+func (rplx *Rplx) switchFlag(name string, policy FlagPolicy, enable bool, expire int64) error {
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newFlagVariable(name, policy.kind())
+	})
+
+	if !v.flag() {
+		return ErrVariableKind
+	}
+
+	if rplx.resetExpired(v) {
+		v.resetParts()
+	}
+
+	v.flagSwitch(rplx.nodeID, enable, expire)
+
+	rplx.localUpdated(v, EventUpsert)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package rplx
+
+import (
+	"math"
+)
+
+// UpsertFloat changes variable of Float kind on delta or creates variable, if not exists
+// returns new variable value, ErrInvalidValue if delta is NaN or infinity
+func (rplx *Rplx) UpsertFloat(name string, delta float64) (float64, error) {
+	if math.IsNaN(delta) || math.IsInf(delta, 0) {
+		return 0, ErrInvalidValue
+	}
+
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newFloatVariable(name)
+	})
+
+	if v.kind != Kind_Float {
+		return 0, ErrVariableKind
+	}
+
+	if rplx.resetExpired(v) {
+		v.resetParts()
+	}
+
+	v.updateFloat(delta)
+
+	rplx.localUpdated(v, EventUpsert)
+
+	return v.floatSum(), nil
+}
+
+// GetFloat returns value of variable of Float kind
+func (rplx *Rplx) GetFloat(name string) (float64, error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return 0, err
+	}
+
+	if v.kind != Kind_Float {
+		return 0, ErrVariableKind
+	}
+
+	return v.floatSum(), nil
+}
@@ -0,0 +1,58 @@
+package rplx
+
+// HIncrBy adds delta to field of variable of Hash kind or creates variable, if not exists
+// returns field value on all nodes
+func (rplx *Rplx) HIncrBy(name string, field string, delta int64) (int64, error) {
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newHashVariable(name)
+	})
+
+	if v.kind != Kind_Hash {
+		return 0, ErrVariableKind
+	}
+
+	if rplx.resetExpired(v) {
+		v.resetParts()
+	}
+
+	v.hashIncr(field, delta)
+
+	rplx.localUpdated(v, EventUpsert)
+
+	value, _ := v.hashGet(field)
+
+	return value, nil
+}
+
+// HGet returns field value of variable of Hash kind on all nodes
+func (rplx *Rplx) HGet(name string, field string) (int64, error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return 0, err
+	}
+
+	if v.kind != Kind_Hash {
+		return 0, ErrVariableKind
+	}
+
+	value, ok := v.hashGet(field)
+	if !ok {
+		return 0, ErrFieldNotExists
+	}
+
+	return value, nil
+}
+
+// HGetAll returns values of all fields of variable of Hash kind on all nodes
+func (rplx *Rplx) HGetAll(name string) (map[string]int64, error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.kind != Kind_Hash {
+		return nil, ErrVariableKind
+	}
+
+	return v.hashGetAll(), nil
+}
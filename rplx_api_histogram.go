@@ -0,0 +1,64 @@
+package rplx
+
+import (
+	"math"
+)
+
+// Observe adds value to variable of Histogram kind or creates variable, if not exists
+// not positive values are counted in separate bucket
+func (rplx *Rplx) Observe(name string, value float64) error {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return ErrInvalidValue
+	}
+
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newHistogramVariable(name)
+	})
+
+	if v.kind != Kind_Histogram {
+		return ErrVariableKind
+	}
+
+	if rplx.resetExpired(v) {
+		v.resetParts()
+	}
+
+	v.observe(value)
+
+	rplx.localUpdated(v, EventUpsert)
+
+	return nil
+}
+
+// Quantile returns value for quantile q in range [0, 1] of observations on all nodes
+// relative error of positive values is 1%, not positive values are returned as 0
+func (rplx *Rplx) Quantile(name string, q float64) (float64, error) {
+	if q < 0 || q > 1 || math.IsNaN(q) {
+		return 0, ErrInvalidQuantile
+	}
+
+	v, err := rplx.load(name)
+	if err != nil {
+		return 0, err
+	}
+
+	if v.kind != Kind_Histogram {
+		return 0, ErrVariableKind
+	}
+
+	return v.histogram().quantile(q), nil
+}
+
+// Buckets returns not empty buckets of variable of Histogram kind, ordered by bounds
+func (rplx *Rplx) Buckets(name string) ([]HistogramBucket, error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.kind != Kind_Histogram {
+		return nil, ErrVariableKind
+	}
+
+	return v.histogram().list(), nil
+}
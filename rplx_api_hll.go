@@ -0,0 +1,40 @@
+package rplx
+
+// PFAdd adds items to variable of HyperLogLog kind or creates variable, if not exists
+// returns true, if estimated count may be changed
+func (rplx *Rplx) PFAdd(name string, items ...string) (bool, error) {
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newHLLVariable(name)
+	})
+
+	if v.kind != Kind_HyperLogLog {
+		return false, ErrVariableKind
+	}
+
+	if rplx.resetExpired(v) {
+		v.resetParts()
+	}
+
+	if !v.hllAdd(items...) {
+		return false, nil
+	}
+
+	rplx.localUpdated(v, EventUpsert)
+
+	return true, nil
+}
+
+// PFCount returns estimated count of distinct items, added to variable of HyperLogLog kind on all nodes
+// standard error of estimation is about 0.8%
+func (rplx *Rplx) PFCount(name string) (int64, error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return 0, err
+	}
+
+	if v.kind != Kind_HyperLogLog {
+		return 0, ErrVariableKind
+	}
+
+	return v.hllCount(), nil
+}
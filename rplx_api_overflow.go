@@ -0,0 +1,75 @@
+package rplx
+
+import (
+	"math/big"
+)
+
+// SetOverflowPolicy sets overflow policy of Counter variable or creates variable with policy, if not exists
+// policy is replicated with variable, the latest set policy wins on all nodes
+// policy is kept while variable exists, variable, removed by GC on all nodes, is created with OverflowWrap policy
+func (rplx *Rplx) SetOverflowPolicy(name string, policy OverflowPolicy) error {
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newVariable(name)
+	})
+
+	if v.kind != Kind_Counter {
+		return ErrVariableKind
+	}
+
+	v.setOverflowPolicy(policy)
+
+	go rplx.sendToReplication(v)
+
+	return nil
+}
+
+// UpsertChecked changes variable on delta or creates variable, if not exists, like Upsert
+// returns ErrOverflow, if update was rejected or value overflows int64 with OverflowError or OverflowBig policy
+func (rplx *Rplx) UpsertChecked(name string, delta int64) (int64, error) {
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newVariable(name)
+	})
+
+	return rplx.upsert(v, delta)
+}
+
+// GetBig returns exact value of Counter variable
+// value is exact, if all nodes use OverflowBig policy
+func (rplx *Rplx) GetBig(name string) (*big.Int, error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.kind != Kind_Counter {
+		return nil, ErrVariableKind
+	}
+
+	return v.counterBig(), nil
+}
+
+// checkedValue returns variable value and ErrOverflow, if value overflows int64 and policy does not allow it
+// counts overflow of variable sum in metrics once, until value returns to int64 range
+func (rplx *Rplx) checkedValue(v *variable) (int64, error) {
+	value, overflow := v.value()
+
+	if v.markOverflow(overflow) {
+		rplx.countOverflow("total")
+	}
+
+	if overflow {
+		switch v.overflowPolicy() {
+		case OverflowError, OverflowBig:
+			return value, ErrOverflow
+		}
+	}
+
+	return value, nil
+}
+
+// countOverflow increments overflows metric
+func (rplx *Rplx) countOverflow(t string) {
+	if rplx.metrics != nil {
+		rplx.metrics.counterOverflows.WithLabelValues(t).Inc()
+	}
+}
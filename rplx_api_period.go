@@ -0,0 +1,40 @@
+package rplx
+
+// UpsertPeriod changes variable of Period kind on delta or creates variable, if not exists
+// value of variable resets to zero at the start of each period (hour, day or month),
+// period boundaries are calculated in fixed zone with zoneOffset (seconds east of UTC, 0 for UTC)
+// period length and zone offset are applied only on variable creation
+// returns value for the current period
+func (rplx *Rplx) UpsertPeriod(name string, delta int64, length PeriodLength, zoneOffset int) (int64, error) {
+	if !validPeriod(length, int64(zoneOffset)) {
+		return 0, ErrInvalidPeriod
+	}
+
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newPeriodVariable(name, length, int64(zoneOffset))
+	})
+
+	if v.kind != Kind_Period {
+		return 0, ErrVariableKind
+	}
+
+	return rplx.upsert(v, delta)
+}
+
+// GetPrevious returns value of variable of Period kind for the previous period
+// the previous period is replicated for a short grace time after the period boundary, then the value is frozen,
+// contributions, received after grace time, are ignored
+func (rplx *Rplx) GetPrevious(name string) (int64, error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return 0, err
+	}
+
+	if v.kind != Kind_Period {
+		return 0, ErrVariableKind
+	}
+
+	_, previous := v.periodValues()
+
+	return previous, nil
+}
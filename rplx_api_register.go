@@ -0,0 +1,35 @@
+package rplx
+
+// SetBytes writes value to variable of Register kind or creates variable, if not exists
+// variable value is the value of the latest write on any node (last writer wins)
+func (rplx *Rplx) SetBytes(name string, value []byte) error {
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newRegisterVariable(name)
+	})
+
+	if v.kind != Kind_Register {
+		return ErrVariableKind
+	}
+
+	rplx.resetExpired(v)
+
+	v.setRegister(value)
+
+	rplx.localUpdated(v, EventUpsert)
+
+	return nil
+}
+
+// GetBytes returns value of variable of Register kind
+func (rplx *Rplx) GetBytes(name string) ([]byte, error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.kind != Kind_Register {
+		return nil, ErrVariableKind
+	}
+
+	return v.registerValue(rplx.nodeID), nil
+}
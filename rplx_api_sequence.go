@@ -0,0 +1,46 @@
+package rplx
+
+import (
+	"context"
+	"time"
+)
+
+// NextID returns next cluster-unique ID of variable of Sequence kind or creates variable, if not exists
+// IDs, issued by the node, are monotonically increasing
+// node reserves slot through replicated variable and waits until reservation is settled (see WithSequenceSettle option),
+// so the first call on the node waits settle duration or until ctx is done
+// IDs are unique, if node clock does not step back behind the last issued ID while node restarts
+func (rplx *Rplx) NextID(ctx context.Context, name string) (int64, error) {
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newSequenceVariable(name)
+	})
+
+	if v.kind != Kind_Sequence {
+		return 0, ErrVariableKind
+	}
+
+	if rplx.resetExpired(v) {
+		v.resetParts()
+	}
+
+	for {
+		id, wait, changed, err := v.sequenceNext(rplx.nodeID, time.Now().UTC().UnixNano(), int64(rplx.sequenceSettle))
+		if changed {
+			rplx.localUpdated(v, EventUpsert)
+		}
+		if err != nil {
+			return 0, err
+		}
+		if wait == 0 {
+			return id, nil
+		}
+
+		timer := time.NewTimer(time.Duration(wait))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return 0, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
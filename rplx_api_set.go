@@ -0,0 +1,80 @@
+package rplx
+
+import (
+	"time"
+)
+
+// SAdd adds members to variable of Set kind or creates variable, if not exists
+// if ttl is greater than zero, members are removed from set after ttl
+// concurrent add and remove of the same member on different nodes resolves in favor of add
+func (rplx *Rplx) SAdd(name string, ttl time.Duration, members ...string) error {
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newSetVariable(name)
+	})
+
+	if v.kind != Kind_Set {
+		return ErrVariableKind
+	}
+
+	if rplx.resetExpired(v) {
+		v.resetParts()
+	}
+
+	var expire int64
+	if ttl > 0 {
+		expire = time.Now().UTC().Add(ttl).UnixNano()
+	}
+
+	v.setAdd(expire, members...)
+
+	rplx.localUpdated(v, EventUpsert)
+
+	return nil
+}
+
+// SRem removes members from variable of Set kind
+// only member adds, observed by the node, are removed
+func (rplx *Rplx) SRem(name string, members ...string) error {
+	v, err := rplx.load(name)
+	if err != nil {
+		return err
+	}
+
+	if v.kind != Kind_Set {
+		return ErrVariableKind
+	}
+
+	v.setRemove(rplx.nodeID, members...)
+
+	rplx.localUpdated(v, EventUpsert)
+
+	return nil
+}
+
+// SMembers returns sorted members of variable of Set kind
+func (rplx *Rplx) SMembers(name string) ([]string, error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.kind != Kind_Set {
+		return nil, ErrVariableKind
+	}
+
+	return v.setMembers(rplx.nodeID), nil
+}
+
+// SIsMember returns true, if member is in variable of Set kind
+func (rplx *Rplx) SIsMember(name string, member string) (bool, error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return false, err
+	}
+
+	if v.kind != Kind_Set {
+		return false, ErrVariableKind
+	}
+
+	return v.setIsMember(rplx.nodeID, member), nil
+}
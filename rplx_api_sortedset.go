@@ -0,0 +1,116 @@
+package rplx
+
+// ZIncrBy adds delta to member score in variable of SortedSet kind or creates variable, if not exists
+// returns member score on all nodes
+func (rplx *Rplx) ZIncrBy(name string, member string, delta int64) (int64, error) {
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newSortedSetVariable(name)
+	})
+
+	if v.kind != Kind_SortedSet {
+		return 0, ErrVariableKind
+	}
+
+	if rplx.resetExpired(v) {
+		v.resetParts()
+	}
+
+	v.hashIncr(member, delta)
+
+	rplx.localUpdated(v, EventUpsert)
+
+	score, _ := v.hashGet(member)
+
+	return score, nil
+}
+
+// ZScore returns member score in variable of SortedSet kind on all nodes
+func (rplx *Rplx) ZScore(name string, member string) (int64, error) {
+	v, err := rplx.loadSortedSet(name)
+	if err != nil {
+		return 0, err
+	}
+
+	score, ok := v.hashGet(member)
+	if !ok {
+		return 0, ErrFieldNotExists
+	}
+
+	return score, nil
+}
+
+// ZRank returns member rank in variable of SortedSet kind, ordered by score from low to high
+func (rplx *Rplx) ZRank(name string, member string) (int, error) {
+	v, err := rplx.loadSortedSet(name)
+	if err != nil {
+		return 0, err
+	}
+
+	rank, ok := v.sortedRank(member, false)
+	if !ok {
+		return 0, ErrFieldNotExists
+	}
+
+	return rank, nil
+}
+
+// ZRevRank returns member rank in variable of SortedSet kind, ordered by score from high to low
+func (rplx *Rplx) ZRevRank(name string, member string) (int, error) {
+	v, err := rplx.loadSortedSet(name)
+	if err != nil {
+		return 0, err
+	}
+
+	rank, ok := v.sortedRank(member, true)
+	if !ok {
+		return 0, ErrFieldNotExists
+	}
+
+	return rank, nil
+}
+
+// ZRange returns members of variable of SortedSet kind with ranks from start to stop inclusive, ordered by score from low to high
+// negative rank is offset from the end, -1 is the last member
+func (rplx *Rplx) ZRange(name string, start, stop int) ([]ScoredMember, error) {
+	v, err := rplx.loadSortedSet(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.sortedRangeByRank(start, stop, false), nil
+}
+
+// ZRevRange returns members of variable of SortedSet kind with ranks from start to stop inclusive, ordered by score from high to low
+// negative rank is offset from the end, -1 is the last member
+func (rplx *Rplx) ZRevRange(name string, start, stop int) ([]ScoredMember, error) {
+	v, err := rplx.loadSortedSet(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.sortedRangeByRank(start, stop, true), nil
+}
+
+// ZRangeByScore returns members of variable of SortedSet kind with score in range [min, max], ordered by score from low to high
+func (rplx *Rplx) ZRangeByScore(name string, min, max int64) ([]ScoredMember, error) {
+	v, err := rplx.loadSortedSet(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.sortedRangeByScore(min, max), nil
+}
+
+// loadSortedSet returns not expired variable of SortedSet kind
+func (rplx *Rplx) loadSortedSet(name string) (*variable, error) {
+	v, err := rplx.load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.kind != Kind_SortedSet {
+		return nil, ErrVariableKind
+	}
+
+	return v, nil
+}
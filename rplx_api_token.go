@@ -0,0 +1,51 @@
+package rplx
+
+import (
+	"time"
+)
+
+// UpsertToken changes variable on delta like Upsert and returns new value with token of the change
+// token is used for GetAfter on another node
+func (rplx *Rplx) UpsertToken(name string, delta int64) (int64, Token, error) {
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newVariable(name)
+	})
+
+	value, err := rplx.upsert(v, delta)
+	if err != nil {
+		return value, Token{}, err
+	}
+
+	return value, Token{NodeID: rplx.nodeID, Version: v.nodeVersion(rplx.nodeID, rplx.nodeID)}, nil
+}
+
+// GetAfter returns variable value like Get, after local node applied the change of token
+// waits until the change is replicated to local node or timeout expires
+func (rplx *Rplx) GetAfter(name string, token Token, timeout time.Duration) (int64, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		// channel is taken before check, so change between check and wait is not missed
+		ch, release := rplx.appliedChan(name)
+
+		v, err := rplx.load(name)
+		if err != nil && err != ErrVariableNotExists {
+			release()
+			return 0, err
+		}
+
+		if err == nil && v.nodeVersion(token.NodeID, rplx.nodeID) >= token.Version {
+			release()
+			return rplx.Get(name)
+		}
+
+		select {
+		case <-ch:
+			release()
+		case <-timer.C:
+			release()
+			return 0, ErrTimeout
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package rplx
+
+import (
+	"time"
+)
+
+// UpsertWindow changes variable of Window kind on delta or creates variable, if not exists
+// value of variable is sum of deltas for the last window, window is divided to buckets with bucket size
+// window and bucket sizes are applied only on variable creation
+// returns new value
+func (rplx *Rplx) UpsertWindow(name string, delta int64, window, bucket time.Duration) (int64, error) {
+	if bucket <= 0 || window < bucket {
+		return 0, ErrInvalidWindow
+	}
+
+	v := rplx.loadOrCreate(name, func() *variable {
+		return newWindowVariable(name, int64(window), int64(bucket))
+	})
+
+	if v.kind != Kind_Window {
+		return 0, ErrVariableKind
+	}
+
+	return rplx.upsert(v, delta)
+}
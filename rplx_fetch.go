@@ -0,0 +1,133 @@
+package rplx
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	fetchCodeOK       = 0
+	fetchCodeNotFound = 1
+
+	// tombstoneDuration - time, while TTL of variable, removed by GC or Delete, is returned by Fetch
+	tombstoneDuration = time.Minute
+)
+
+// tombstone describe variable, removed from local node
+type tombstone struct {
+	variable *variable
+	until    int64
+}
+
+// addTombstone keeps removed variable, so Fetch reports expiration of variable after removal
+func (rplx *Rplx) addTombstone(v *variable) {
+	t := &tombstone{
+		variable: v,
+		until:    time.Now().UTC().Add(tombstoneDuration).UnixNano(),
+	}
+
+	rplx.tombstonesMx.Lock()
+	rplx.tombstones[v.name] = t
+	rplx.tombstonesMx.Unlock()
+}
+
+// loadTombstone returns replicated data of removed variable: TTL and kind options without nodes values
+func (rplx *Rplx) loadTombstone(name string, now int64) (*SyncVariable, bool) {
+	rplx.tombstonesMx.Lock()
+	t, ok := rplx.tombstones[name]
+	rplx.tombstonesMx.Unlock()
+
+	if !ok || t.until < now {
+		return nil, false
+	}
+
+	sv := &SyncVariable{
+		TTL:         t.variable.TTL(),
+		TTLVersion:  t.variable.TTLVersion(),
+		NodesValues: make(map[string]*SyncNodeValue),
+	}
+
+	t.variable.syncOptions(sv)
+
+	return sv, true
+}
+
+// pruneTombstones removes tombstones older than tombstoneDuration
+func (rplx *Rplx) pruneTombstones(now int64) {
+	rplx.tombstonesMx.Lock()
+	for name, t := range rplx.tombstones {
+		if t.until < now {
+			delete(rplx.tombstones, name)
+		}
+	}
+	rplx.tombstonesMx.Unlock()
+}
+
+// Fetch is GRPC function, fired on incoming request for variable values of all nodes, known on local node
+// expired variable and variable, deleted on another node, are returned with TTL, so requesting node applies expiration,
+// variable, removed from local node by GC or Delete, is returned with TTL during tombstoneDuration after removal
+func (rplx *Rplx) Fetch(ctx context.Context, req *FetchRequest) (*FetchResponse, error) {
+	rplx.variablesMx.RLock()
+	v, ok := rplx.variables[req.Name]
+	rplx.variablesMx.RUnlock()
+
+	if !ok {
+		if sv, ok := rplx.loadTombstone(req.Name, time.Now().UTC().UnixNano()); ok {
+			return &FetchResponse{Code: fetchCodeOK, Variable: sv}, nil
+		}
+
+		return &FetchResponse{Code: fetchCodeNotFound}, nil
+	}
+
+	return &FetchResponse{
+		Code:     fetchCodeOK,
+		Variable: v.syncVariable(rplx.nodeID),
+	}, nil
+}
+
+// fetch requests variable values from remote node
+func (n *node) fetch(ctx context.Context, name string) (*FetchResponse, error) {
+	return n.replicatorClient.Fetch(ctx, &FetchRequest{
+		NodeID: n.localNodeID,
+		Name:   name,
+	})
+}
+
+// syncVariable returns variable with full data of all nodes for replication
+func (v *variable) syncVariable(localNodeID string) *SyncVariable {
+	sv := &SyncVariable{
+		TTL:         v.TTL(),
+		TTLVersion:  v.TTLVersion(),
+		NodesValues: make(map[string]*SyncNodeValue),
+	}
+
+	v.syncOptions(sv)
+
+	if v.kind != Kind_Counter {
+		v.eachPart(localNodeID, func(nodeID string, p part) {
+			sv.NodesValues[nodeID] = p.syncValue()
+		})
+
+		return sv
+	}
+
+	value, high, version := v.self.snapshot()
+	sv.NodesValues[localNodeID] = &SyncNodeValue{
+		Value:     value,
+		ValueHigh: high,
+		Version:   version,
+	}
+
+	v.remoteItemsMx.RLock()
+	for nodeID, item := range v.remoteItems {
+		value, high, version := item.snapshot()
+		sv.NodesValues[nodeID] = &SyncNodeValue{
+			Value:     value,
+			ValueHigh: high,
+			Version:   version,
+		}
+	}
+	v.remoteItemsMx.RUnlock()
+
+	return sv
+}
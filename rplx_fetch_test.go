@@ -0,0 +1,179 @@
+package rplx
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"testing"
+	"time"
+)
+
+// localReplicatorClient calls server methods directly
+type localReplicatorClient struct {
+	server ReplicatorServer
+}
+
+func (c *localReplicatorClient) Hello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloResponse, error) {
+	return c.server.Hello(ctx, in)
+}
+
+func (c *localReplicatorClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error) {
+	return c.server.Sync(ctx, in)
+}
+
+func (c *localReplicatorClient) Rights(ctx context.Context, in *RightsRequest, opts ...grpc.CallOption) (*RightsResponse, error) {
+	return c.server.Rights(ctx, in)
+}
+
+func (c *localReplicatorClient) Fetch(ctx context.Context, in *FetchRequest, opts ...grpc.CallOption) (*FetchResponse, error) {
+	return c.server.Fetch(ctx, in)
+}
+
+func TestRplx_Fetch(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	resp, err := r.Fetch(context.Background(), &FetchRequest{NodeID: "node2", Name: "A"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(fetchCodeNotFound), resp.Code)
+
+	r.Upsert("A", 5)
+	r.sync(&SyncRequest{
+		NodeID: "node3",
+		Variables: map[string]*SyncVariable{
+			"A": {NodesValues: map[string]*SyncNodeValue{"node3": {Value: 2, Version: 1}}},
+		},
+	})
+
+	resp, err = r.Fetch(context.Background(), &FetchRequest{NodeID: "node2", Name: "A"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(fetchCodeOK), resp.Code)
+	assert.Equal(t, Kind_Counter, resp.Variable.Kind)
+	require.Len(t, resp.Variable.NodesValues, 2)
+	assert.Equal(t, int64(5), resp.Variable.NodesValues["node1"].Value)
+	assert.Equal(t, int64(2), resp.Variable.NodesValues["node3"].Value)
+}
+
+func TestRplx_FetchTombstone(t *testing.T) {
+	r := New(WithNodeID("node1"))
+	close(r.gcStop)
+
+	r.Upsert("A", 5)
+	require.NoError(t, r.Delete("A"))
+
+	resp, err := r.Fetch(context.Background(), &FetchRequest{NodeID: "node2", Name: "A"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(fetchCodeOK), resp.Code)
+	assert.Equal(t, Kind_Counter, resp.Variable.Kind)
+	assert.True(t, resp.Variable.TTL > 0 && resp.Variable.TTL < time.Now().UTC().UnixNano())
+	assert.Empty(t, resp.Variable.NodesValues)
+
+	// tombstone is removed after tombstoneDuration
+	r.gc()
+	_, ok := r.loadTombstone("A", time.Now().UTC().UnixNano())
+	assert.True(t, ok)
+
+	r.pruneTombstones(time.Now().UTC().Add(tombstoneDuration * 2).UnixNano())
+	resp, err = r.Fetch(context.Background(), &FetchRequest{NodeID: "node2", Name: "A"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(fetchCodeNotFound), resp.Code)
+}
+
+func TestRplx_GetFresh(t *testing.T) {
+	r1 := New(WithNodeID("node1"))
+	r2 := New(WithNodeID("node2"))
+
+	// variables are removed from r1 only by explicit gc call
+	close(r1.gcStop)
+	close(r2.gcStop)
+
+	_, err := r1.HIncrBy("A", "a", 5)
+	require.NoError(t, err)
+
+	failClient := &replicatorClientMock{}
+	failClient.On("Fetch", mock.Anything, mock.Anything, mock.Anything).Return(&FetchResponse{}, errors.New("unavailable"))
+
+	r2.nodesMx.Lock()
+	r2.nodes["addr1"] = &node{
+		logger:           zap.NewNop(),
+		connected:        1,
+		localNodeID:      "node2",
+		remoteNodeID:     "node1",
+		replicatorClient: &localReplicatorClient{server: r1},
+		replicationChan:  make(chan *variable, 10),
+	}
+	r2.nodes["addr3"] = &node{
+		logger:           zap.NewNop(),
+		connected:        1,
+		localNodeID:      "node2",
+		remoteNodeID:     "node3",
+		replicatorClient: failClient,
+		replicationChan:  make(chan *variable, 10),
+	}
+	r2.nodesMx.Unlock()
+
+	// variable of not numeric kind is merged, but Get returns kind error
+	_, responded, err := r2.GetFresh(context.Background(), "A")
+	assert.Equal(t, ErrVariableKind, err)
+	assert.Equal(t, 1, responded)
+
+	value, err := r2.HGet("A", "a")
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), value)
+
+	r1.Upsert("B", 5)
+	r2.Upsert("B", 3)
+
+	value, responded, err = r2.GetFresh(context.Background(), "B")
+	require.NoError(t, err)
+	assert.Equal(t, 1, responded)
+	assert.Equal(t, int64(8), value)
+
+	// variable, not known on any node
+	_, responded, err = r2.GetFresh(context.Background(), "C")
+	assert.Equal(t, ErrVariableNotExists, err)
+	assert.Equal(t, 1, responded)
+
+	// variable, deleted on node3, is expired on node1, expiration is applied on node2
+	expire := func(name string) {
+		r1.sync(&SyncRequest{
+			NodeID: "node3",
+			Variables: map[string]*SyncVariable{
+				name: {TTL: time.Now().UTC().Add(-time.Second).UnixNano(), TTLVersion: time.Now().UTC().UnixNano()},
+			},
+		})
+	}
+
+	r2.Upsert("D", 3)
+	r1.Upsert("D", 5)
+	expire("D")
+
+	_, responded, err = r2.GetFresh(context.Background(), "D")
+	assert.Equal(t, ErrVariableExpired, err)
+	assert.Equal(t, 1, responded)
+
+	// expired variable is removed from node1 by GC
+	r2.Upsert("E", 3)
+	r1.Upsert("E", 5)
+	expire("E")
+	r1.gc()
+
+	_, err = r1.Get("E")
+	require.Equal(t, ErrVariableNotExists, err)
+
+	_, responded, err = r2.GetFresh(context.Background(), "E")
+	assert.Equal(t, ErrVariableExpired, err)
+	assert.Equal(t, 1, responded)
+
+	// variable is deleted on node1
+	r2.Upsert("F", 3)
+	r1.Upsert("F", 5)
+	require.NoError(t, r1.Delete("F"))
+
+	_, responded, err = r2.GetFresh(context.Background(), "F")
+	assert.Equal(t, ErrVariableExpired, err)
+	assert.Equal(t, 1, responded)
+}
@@ -22,7 +22,8 @@ func WithLogger(logger *zap.Logger) Option {
 	}
 }
 
-// WithGCInterval option for set garbage collect interval
+// WithGCInterval option for set maximum garbage collect interval
+// GC wakes up at the nearest variable TTL, the interval limits sleep time between GC runs
 func WithGCInterval(interval time.Duration) Option {
 	return func(rplx *Rplx) {
 		rplx.gcInterval = interval
@@ -63,3 +64,26 @@ func WithMetrics() Option {
 		rplx.withMetrics = true
 	}
 }
+
+// WithCustomType option for register user-defined replicated type with name
+// all nodes must register the same types with the same names
+func WithCustomType(name string, t CustomType) Option {
+	return func(rplx *Rplx) {
+		rplx.customTypes[name] = t
+	}
+}
+
+// WithSequenceSettle option for set time after slot claim of Sequence variable, before node issues IDs
+// conflicting claims of other nodes must be replicated in this time, use a few remote nodes sync intervals
+func WithSequenceSettle(settle time.Duration) Option {
+	return func(rplx *Rplx) {
+		rplx.sequenceSettle = settle
+	}
+}
+
+// WithWatchBufferSize option for set Watch subscriber channel capacity
+func WithWatchBufferSize(size int) Option {
+	return func(rplx *Rplx) {
+		rplx.watchBufferSize = size
+	}
+}
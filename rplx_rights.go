@@ -0,0 +1,65 @@
+package rplx
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"sync/atomic"
+)
+
+const (
+	rightsCodeOK       = 0
+	rightsCodeNotFound = 1
+)
+
+// Rights is GRPC function, fired on incoming request for rights of variable of Bounded kind
+// grants up to half of local node rights and returns local node part with the transfer
+func (rplx *Rplx) Rights(ctx context.Context, req *RightsRequest) (*RightsResponse, error) {
+	v, err := rplx.load(req.Name)
+	if err != nil || v.kind != Kind_Bounded || req.NodeID == rplx.nodeID {
+		return &RightsResponse{Code: rightsCodeNotFound}, nil
+	}
+
+	granted := v.grantRights(rplx.nodeID, req.NodeID, req.Amount)
+
+	rplx.logger.Debug("grant rights", zap.String("name", req.Name), zap.String("to node", req.NodeID), zap.Int64("amount", req.Amount), zap.Int64("granted", granted))
+
+	if granted > 0 {
+		go rplx.sendToReplication(v)
+	}
+
+	return &RightsResponse{
+		Code:    rightsCodeOK,
+		Granted: granted,
+		Value:   v.selfPart.syncValue(),
+	}, nil
+}
+
+// connectedNodes returns connected remote nodes
+func (rplx *Rplx) connectedNodes() []*node {
+	rplx.nodesMx.RLock()
+	defer rplx.nodesMx.RUnlock()
+
+	nodes := make([]*node, 0, len(rplx.nodes))
+	for _, n := range rplx.nodes {
+		if atomic.LoadInt32(&n.connected) == 1 {
+			nodes = append(nodes, n)
+		}
+	}
+
+	return nodes
+}
+
+// requestRights requests rights for variable from remote node
+func (n *node) requestRights(ctx context.Context, name string, amount int64) (*RightsResponse, error) {
+	resp, err := n.replicatorClient.Rights(ctx, &RightsRequest{
+		NodeID: n.localNodeID,
+		Name:   name,
+		Amount: amount,
+	})
+	if err != nil {
+		n.logger.Warn("error request rights from remote node", zap.String("addr", n.addr), zap.String("name", name), zap.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -3,6 +3,8 @@ package rplx
 import (
 	"context"
 	"go.uber.org/zap"
+	"sync"
+	"time"
 )
 
 // Sync is GRPC function, fired on incoming sync message
@@ -11,9 +13,61 @@ func (rplx *Rplx) Sync(ctx context.Context, req *SyncRequest) (*SyncResponse, er
 
 	rplx.metrics.variablesGot.WithLabelValues(req.NodeID).Add(float64(len(req.Variables)))
 
-	go rplx.sync(req)
+	// requests of sender are applied in order, so base versions of changes are checked after previous request is applied
+	senderMx := rplx.senderLock(req.NodeID)
+	senderMx.Lock()
 
-	return &SyncResponse{Code: 0}, nil
+	resync := rplx.missingBase(req)
+
+	go func() {
+		defer senderMx.Unlock()
+		rplx.sync(req)
+	}()
+
+	return &SyncResponse{Code: 0, Resync: resync}, nil
+}
+
+// senderLock returns mutex, which serializes applying of sync requests from node
+func (rplx *Rplx) senderLock(nodeID string) *sync.Mutex {
+	rplx.sendersMx.Lock()
+	defer rplx.sendersMx.Unlock()
+
+	mx, ok := rplx.senders[nodeID]
+	if !ok {
+		mx = &sync.Mutex{}
+		rplx.senders[nodeID] = mx
+	}
+
+	return mx
+}
+
+// missingBase returns keys <VARIABLE_NAME>@<NODE_ID> of changes, which base version is not applied on local node
+// e.g. after local node restart, sender replicates full state of these parts
+func (rplx *Rplx) missingBase(req *SyncRequest) []string {
+	var result []string
+
+	for name, sv := range req.Variables {
+		rplx.variablesMx.RLock()
+		v, ok := rplx.variables[name]
+		rplx.variablesMx.RUnlock()
+
+		// variable of another kind is not synced, so full state does not help
+		if ok && v.kind != sv.Kind {
+			continue
+		}
+
+		for nodeID, n := range sv.NodesValues {
+			if n.Since == 0 || nodeID == rplx.nodeID {
+				continue
+			}
+
+			if !ok || v.nodeVersion(nodeID, rplx.nodeID) < n.Since {
+				result = append(result, name+"@"+nodeID)
+			}
+		}
+	}
+
+	return result
 }
 
 func (rplx *Rplx) sync(req *SyncRequest) {
@@ -21,11 +75,29 @@ func (rplx *Rplx) sync(req *SyncRequest) {
 		rplx.variablesMx.Lock()
 		localVar, ok := rplx.variables[name]
 		if !ok {
-			localVar = newVariable(name)
+			localVar, ok = rplx.newSyncVariable(name, v)
+			if !ok {
+				rplx.variablesMx.Unlock()
+				rplx.logger.Error("bad variable kind or kind options", zap.String("name", name), zap.String("kind", v.Kind.String()), zap.String("from node", req.NodeID))
+				continue
+			}
 			rplx.variables[name] = localVar
+			rplx.registerCompactable(localVar)
+		}
+
+		if localVar.kind != v.Kind {
+			rplx.variablesMx.Unlock()
+			rplx.logger.Error("variable kind mismatch", zap.String("name", name), zap.String("local kind", localVar.kind.String()), zap.String("remote kind", v.Kind.String()), zap.String("from node", req.NodeID))
+			continue
+		}
+
+		if localVar.kind == Kind_Custom && localVar.customTypeName() != v.CustomType {
+			rplx.variablesMx.Unlock()
+			rplx.logger.Error("variable custom type mismatch", zap.String("name", name), zap.String("local type", localVar.customTypeName()), zap.String("remote type", v.CustomType), zap.String("from node", req.NodeID))
+			continue
 		}
 
-		varWasUpdated := false
+		varWasUpdated := localVar.kind == Kind_Counter && localVar.mergeOverflow(v.Overflow)
 
 		var remoteNodeInstance *node
 
@@ -35,13 +107,16 @@ func (rplx *Rplx) sync(req *SyncRequest) {
 		}
 		rplx.nodesMx.RUnlock()
 
+		watched := rplx.hasWatchers(name)
+		events := make([]Event, 0)
+
 		for nodeID, n := range v.NodesValues {
 			// Если мы получили данные с нашим remoteNodeID, пропускаем
 			if nodeID == rplx.nodeID {
 				continue
 			}
 
-			if localVar.updateItem(nodeID, n.Value, n.Version) {
+			if localVar.updateNodeValue(nodeID, n) {
 				varWasUpdated = true
 
 				// Если нода, от которой пришли данные, есть у нас в списке - куда мы шлем обновления,
@@ -51,6 +126,10 @@ func (rplx *Rplx) sync(req *SyncRequest) {
 					remoteNodeInstance.replicatedVersions[name+"@"+nodeID] = n.Version
 					remoteNodeInstance.replicatedVersionsMx.Unlock()
 				}
+
+				if watched {
+					events = append(events, rplx.remoteEvent(EventRemoteUpdate, localVar, nodeID))
+				}
 			}
 		}
 
@@ -58,11 +137,36 @@ func (rplx *Rplx) sync(req *SyncRequest) {
 			localVar.ttl = v.TTL
 			localVar.ttlVersion = v.TTLVersion
 			varWasUpdated = true
+
+			rplx.scheduleExpiry(name, v.TTL)
+
+			if watched {
+				eventType := EventTTL
+				// remote Delete replicates as TTL in the past
+				if v.TTL > 0 && v.TTL < time.Now().UTC().UnixNano() {
+					eventType = EventDelete
+				}
+				events = append(events, rplx.remoteEvent(eventType, localVar, req.NodeID))
+			}
 		}
 
 		rplx.variablesMx.Unlock()
 
+		if varWasUpdated && localVar.kind == Kind_SortedSet {
+			localVar.updateSortedIndex()
+		}
+
+		rplx.notify(events...)
+
 		if varWasUpdated {
+			rplx.notifyApplied(name)
+
+			if ttl := localVar.TTL(); ttl > 0 && ttl < time.Now().UTC().UnixNano() {
+				rplx.checkTriggers(name, nil, true)
+			} else {
+				rplx.checkTriggers(name, localVar, true)
+			}
+
 			go rplx.sendToReplication(localVar)
 		}
 	}
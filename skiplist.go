@@ -0,0 +1,186 @@
+package rplx
+
+import (
+	"math/rand"
+)
+
+const (
+	skipListMaxLevel = 32
+	// skipListP - probability of node level increment is 1/skipListP
+	skipListP = 4
+)
+
+// skipNode is skip list node, span[i] is count of ranks between node and next[i]
+type skipNode struct {
+	member string
+	score  int64
+	next   []*skipNode
+	span   []int
+}
+
+// skipList is list of members, ordered by score and member, with rank search in O(log N)
+// skip list is not safe for concurrent use
+type skipList struct {
+	head   *skipNode
+	level  int
+	length int
+}
+
+func newSkipList() *skipList {
+	return &skipList{
+		head: &skipNode{
+			next: make([]*skipNode, skipListMaxLevel),
+			span: make([]int, skipListMaxLevel),
+		},
+		level: 1,
+	}
+}
+
+// less returns true, if node is ordered before member with score
+func (n *skipNode) less(score int64, member string) bool {
+	return n.score < score || (n.score == score && n.member < member)
+}
+
+func randomSkipLevel() int {
+	level := 1
+	for level < skipListMaxLevel && rand.Intn(skipListP) == 0 {
+		level++
+	}
+
+	return level
+}
+
+// insert adds member with score, member must not be in the list
+func (l *skipList) insert(score int64, member string) {
+	var update [skipListMaxLevel]*skipNode
+	var rank [skipListMaxLevel]int
+
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		if i < l.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.next[i] != nil && x.next[i].less(score, member) {
+			rank[i] += x.span[i]
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+
+	level := randomSkipLevel()
+	if level > l.level {
+		for i := l.level; i < level; i++ {
+			update[i] = l.head
+			l.head.span[i] = l.length
+		}
+		l.level = level
+	}
+
+	n := &skipNode{
+		member: member,
+		score:  score,
+		next:   make([]*skipNode, level),
+		span:   make([]int, level),
+	}
+
+	for i := 0; i < level; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+		n.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+
+	for i := level; i < l.level; i++ {
+		update[i].span[i]++
+	}
+
+	l.length++
+}
+
+// remove removes member with score, returns false, if member with score is not in the list
+func (l *skipList) remove(score int64, member string) bool {
+	var update [skipListMaxLevel]*skipNode
+
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && x.next[i].less(score, member) {
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+
+	x = x.next[0]
+	if x == nil || x.score != score || x.member != member {
+		return false
+	}
+
+	for i := 0; i < l.level; i++ {
+		if update[i].next[i] == x {
+			update[i].span[i] += x.span[i] - 1
+			update[i].next[i] = x.next[i]
+			continue
+		}
+		update[i].span[i]--
+	}
+
+	for l.level > 1 && l.head.next[l.level-1] == nil {
+		l.level--
+	}
+
+	l.length--
+
+	return true
+}
+
+// rank returns 0-based rank of member with score, -1 if member with score is not in the list
+func (l *skipList) rank(score int64, member string) int {
+	rank := 0
+
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && (x.next[i].less(score, member) || (x.next[i].score == score && x.next[i].member == member)) {
+			rank += x.span[i]
+			x = x.next[i]
+		}
+		if x != l.head && x.score == score && x.member == member {
+			return rank - 1
+		}
+	}
+
+	return -1
+}
+
+// byRank returns node with 0-based rank, nil if rank is out of range
+func (l *skipList) byRank(rank int) *skipNode {
+	if rank < 0 || rank >= l.length {
+		return nil
+	}
+
+	traversed := 0
+	target := rank + 1
+
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && traversed+x.span[i] <= target {
+			traversed += x.span[i]
+			x = x.next[i]
+		}
+		if traversed == target {
+			return x
+		}
+	}
+
+	return nil
+}
+
+// firstFrom returns the first node with score greater or equal to min, nil if not exists
+func (l *skipList) firstFrom(min int64) *skipNode {
+	x := l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && x.next[i].score < min {
+			x = x.next[i]
+		}
+	}
+
+	return x.next[0]
+}
@@ -0,0 +1,63 @@
+package rplx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func TestSkipList(t *testing.T) {
+	l := newSkipList()
+	scores := make(map[string]int64)
+
+	for i := 0; i < 2000; i++ {
+		member := "m" + strconv.Itoa(rand.Intn(300))
+		score := int64(rand.Intn(50))
+
+		if old, ok := scores[member]; ok {
+			require.True(t, l.remove(old, member))
+		}
+		if i%7 == 0 {
+			delete(scores, member)
+			continue
+		}
+
+		l.insert(score, member)
+		scores[member] = score
+	}
+
+	expected := make([]ScoredMember, 0, len(scores))
+	for member, score := range scores {
+		expected = append(expected, ScoredMember{Member: member, Score: score})
+	}
+	sort.Slice(expected, func(i, j int) bool {
+		if expected[i].Score == expected[j].Score {
+			return expected[i].Member < expected[j].Member
+		}
+		return expected[i].Score < expected[j].Score
+	})
+
+	require.Equal(t, len(expected), l.length)
+
+	for rank, m := range expected {
+		assert.Equal(t, rank, l.rank(m.Score, m.Member))
+
+		n := l.byRank(rank)
+		require.NotNil(t, n)
+		assert.Equal(t, m, ScoredMember{Member: n.member, Score: n.score})
+	}
+
+	assert.Equal(t, -1, l.rank(1, "unknown"))
+	assert.Nil(t, l.byRank(-1))
+	assert.Nil(t, l.byRank(len(expected)))
+	assert.False(t, l.remove(1, "unknown"))
+
+	first := sort.Search(len(expected), func(i int) bool { return expected[i].Score >= 25 })
+	n := l.firstFrom(25)
+	require.NotNil(t, n)
+	assert.Equal(t, expected[first].Member, n.member)
+	assert.Nil(t, l.firstFrom(50))
+}
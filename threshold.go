@@ -0,0 +1,179 @@
+package rplx
+
+import (
+	"sync"
+)
+
+// ThresholdDirection describe direction of threshold crossing
+type ThresholdDirection int
+
+const (
+	// ThresholdUp - value became greater or equal to threshold
+	ThresholdUp ThresholdDirection = iota
+	// ThresholdDown - value became less than threshold minus hysteresis
+	ThresholdDown
+)
+
+func (d ThresholdDirection) String() string {
+	if d == ThresholdUp {
+		return "up"
+	}
+
+	return "down"
+}
+
+// ThresholdEvent describe threshold crossing, passed to OnThreshold callback
+type ThresholdEvent struct {
+	Name      string
+	Threshold int64
+	Direction ThresholdDirection
+	// Value is cluster-wide variable value, which crossed the threshold
+	Value int64
+	// Replicated is true if crossing caused by replication from remote node
+	Replicated bool
+}
+
+// trigger describe threshold registered with OnThreshold
+type trigger struct {
+	name       string
+	threshold  int64
+	hysteresis int64
+	fn         func(ThresholdEvent)
+
+	mx    sync.Mutex
+	above bool
+	queue []ThresholdEvent
+
+	signal chan struct{}
+	stop   chan struct{}
+}
+
+// OnThreshold registers callback fn, called when cluster-wide value of variable crosses threshold
+// crossing up fires when value becomes greater or equal to threshold
+// crossing down fires when value becomes less than threshold minus hysteresis
+// each crossing delivered once, callbacks for one trigger called sequentially in the separate goroutine
+// returns function for unregister the trigger
+func (rplx *Rplx) OnThreshold(name string, threshold, hysteresis int64, fn func(ThresholdEvent)) func() {
+	if hysteresis < 0 {
+		hysteresis = 0
+	}
+
+	t := &trigger{
+		name:       name,
+		threshold:  threshold,
+		hysteresis: hysteresis,
+		fn:         fn,
+		signal:     make(chan struct{}, 1),
+		stop:       make(chan struct{}),
+	}
+
+	// initial state, without fire callback
+	if value, err := rplx.Get(name); err == nil {
+		t.above = value >= threshold
+	}
+
+	rplx.triggersMx.Lock()
+	rplx.triggersSeq++
+	id := rplx.triggersSeq
+	if _, ok := rplx.triggers[name]; !ok {
+		rplx.triggers[name] = make(map[uint64]*trigger)
+	}
+	rplx.triggers[name][id] = t
+	rplx.triggersMx.Unlock()
+
+	go t.run()
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			rplx.triggersMx.Lock()
+			if _, ok := rplx.triggers[name][id]; ok {
+				delete(rplx.triggers[name], id)
+				if len(rplx.triggers[name]) == 0 {
+					delete(rplx.triggers, name)
+				}
+				close(t.stop)
+			}
+			rplx.triggersMx.Unlock()
+		})
+	}
+}
+
+// checkTriggers checks variable triggers, if variable is nil - variable was removed and value is 0
+func (rplx *Rplx) checkTriggers(name string, v *variable, replicated bool) {
+	rplx.triggersMx.RLock()
+	defer rplx.triggersMx.RUnlock()
+
+	for _, t := range rplx.triggers[name] {
+		t.check(v, replicated)
+	}
+}
+
+// stopTriggers stops all triggers
+func (rplx *Rplx) stopTriggers() {
+	rplx.triggersMx.Lock()
+	for name, triggers := range rplx.triggers {
+		for _, t := range triggers {
+			close(t.stop)
+		}
+		delete(rplx.triggers, name)
+	}
+	rplx.triggersMx.Unlock()
+}
+
+func (t *trigger) check(v *variable, replicated bool) {
+	t.mx.Lock()
+
+	// value calculated under trigger lock, so concurrent checks see values in order
+	var value int64
+	if v != nil {
+		value = v.sum()
+	}
+
+	e := ThresholdEvent{
+		Name:       t.name,
+		Threshold:  t.threshold,
+		Value:      value,
+		Replicated: replicated,
+	}
+
+	switch {
+	case !t.above && value >= t.threshold:
+		t.above = true
+		e.Direction = ThresholdUp
+	case t.above && value < t.threshold-t.hysteresis:
+		t.above = false
+		e.Direction = ThresholdDown
+	default:
+		t.mx.Unlock()
+		return
+	}
+
+	t.queue = append(t.queue, e)
+	t.mx.Unlock()
+
+	select {
+	case t.signal <- struct{}{}:
+	default:
+	}
+}
+
+// run calls trigger callback for queued events
+func (t *trigger) run() {
+	for {
+		select {
+		case <-t.stop:
+			return
+		case <-t.signal:
+			t.mx.Lock()
+			events := t.queue
+			t.queue = nil
+			t.mx.Unlock()
+
+			for _, e := range events {
+				t.fn(e)
+			}
+		}
+	}
+}
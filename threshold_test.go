@@ -0,0 +1,105 @@
+package rplx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func receiveThresholdEvent(t *testing.T, ch <-chan ThresholdEvent) ThresholdEvent {
+	t.Helper()
+
+	select {
+	case e := <-ch:
+		return e
+	case <-time.After(time.Second):
+		require.FailNow(t, "threshold event not received")
+	}
+
+	return ThresholdEvent{}
+}
+
+func TestThreshold_CrossingWithHysteresis(t *testing.T) {
+	r := New()
+
+	ch := make(chan ThresholdEvent, 10)
+	cancel := r.OnThreshold("A", 100, 10, func(e ThresholdEvent) { ch <- e })
+	defer cancel()
+
+	r.Upsert("A", 99)
+	r.Upsert("A", 1)
+	r.Upsert("A", 50)
+
+	e := receiveThresholdEvent(t, ch)
+	assert.Equal(t, ThresholdUp, e.Direction)
+	assert.Equal(t, int64(100), e.Value)
+	assert.False(t, e.Replicated)
+
+	// 95 is inside hysteresis range
+	r.Upsert("A", -55)
+	// 89 is less than threshold - hysteresis
+	r.Upsert("A", -6)
+	r.Upsert("A", -10)
+
+	e = receiveThresholdEvent(t, ch)
+	assert.Equal(t, ThresholdDown, e.Direction)
+	assert.Equal(t, int64(89), e.Value)
+
+	time.Sleep(time.Millisecond * 50)
+	assert.Len(t, ch, 0)
+}
+
+func TestThreshold_ReplicatedUpdate(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	ch := make(chan ThresholdEvent, 10)
+	cancel := r.OnThreshold("A", 100, 0, func(e ThresholdEvent) { ch <- e })
+	defer cancel()
+
+	r.Upsert("A", 50)
+
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {NodesValues: map[string]*SyncNodeValue{"node2": {Value: 60, Version: 1}}},
+		},
+	})
+
+	e := receiveThresholdEvent(t, ch)
+	assert.Equal(t, ThresholdUp, e.Direction)
+	assert.Equal(t, int64(110), e.Value)
+	assert.True(t, e.Replicated)
+}
+
+func TestThreshold_InitialStateAndDelete(t *testing.T) {
+	r := New()
+
+	r.Upsert("A", 200)
+
+	ch := make(chan ThresholdEvent, 10)
+	cancel := r.OnThreshold("A", 100, 0, func(e ThresholdEvent) { ch <- e })
+	defer cancel()
+
+	r.Upsert("A", 1)
+	require.NoError(t, r.Delete("A"))
+
+	e := receiveThresholdEvent(t, ch)
+	assert.Equal(t, ThresholdDown, e.Direction)
+	assert.Equal(t, int64(0), e.Value)
+}
+
+func TestThreshold_Cancel(t *testing.T) {
+	r := New()
+
+	ch := make(chan ThresholdEvent, 10)
+	cancel := r.OnThreshold("A", 1, 0, func(e ThresholdEvent) { ch <- e })
+	cancel()
+	cancel()
+
+	r.Upsert("A", 10)
+
+	time.Sleep(time.Millisecond * 50)
+	assert.Len(t, ch, 0)
+	assert.Len(t, r.triggers, 0)
+}
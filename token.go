@@ -0,0 +1,74 @@
+package rplx
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Token describe variable change on node, used for read your writes on another node
+type Token struct {
+	NodeID  string
+	Version int64
+}
+
+// String returns token in format version:nodeID
+func (t Token) String() string {
+	return strconv.FormatInt(t.Version, 10) + ":" + t.NodeID
+}
+
+// ParseToken parses token, returned by Token.String
+func ParseToken(s string) (Token, error) {
+	idx := strings.Index(s, ":")
+	if idx < 0 {
+		return Token{}, ErrInvalidToken
+	}
+
+	version, err := strconv.ParseInt(s[:idx], 10, 64)
+	if err != nil || version <= 0 || idx == len(s)-1 {
+		return Token{}, ErrInvalidToken
+	}
+
+	return Token{NodeID: s[idx+1:], Version: version}, nil
+}
+
+// appliedWaiters describe channel of GetAfter waiters of variable
+type appliedWaiters struct {
+	ch      chan struct{}
+	waiters int
+}
+
+// appliedChan returns channel, which is closed, when variable is changed by replication,
+// and release function, which must be called, when waiter stops waiting
+// channel is removed, when the last waiter releases it, so channels of never replicated variables do not leak
+func (rplx *Rplx) appliedChan(name string) (chan struct{}, func()) {
+	rplx.appliedMx.Lock()
+	defer rplx.appliedMx.Unlock()
+
+	w, ok := rplx.applied[name]
+	if !ok {
+		w = &appliedWaiters{ch: make(chan struct{})}
+		rplx.applied[name] = w
+	}
+	w.waiters++
+
+	release := func() {
+		rplx.appliedMx.Lock()
+		w.waiters--
+		if w.waiters == 0 && rplx.applied[name] == w {
+			delete(rplx.applied, name)
+		}
+		rplx.appliedMx.Unlock()
+	}
+
+	return w.ch, release
+}
+
+// notifyApplied wakes up waiters of variable, changed by replication
+func (rplx *Rplx) notifyApplied(name string) {
+	rplx.appliedMx.Lock()
+	if w, ok := rplx.applied[name]; ok {
+		close(w.ch)
+		delete(rplx.applied, name)
+	}
+	rplx.appliedMx.Unlock()
+}
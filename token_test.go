@@ -0,0 +1,109 @@
+package rplx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestParseToken(t *testing.T) {
+	token := Token{NodeID: "node:1", Version: 10}
+
+	parsed, err := ParseToken(token.String())
+	require.NoError(t, err)
+	assert.Equal(t, token, parsed)
+
+	for _, s := range []string{"", "10", "10:", "a:node1", "0:node1"} {
+		_, err = ParseToken(s)
+		assert.Equal(t, ErrInvalidToken, err, s)
+	}
+}
+
+func TestAPI_GetAfter(t *testing.T) {
+	r1 := New(WithNodeID("node1"))
+	r2 := New(WithNodeID("node2"))
+
+	value, token, err := r1.UpsertToken("A", 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), value)
+	assert.Equal(t, "node1", token.NodeID)
+
+	// change is applied on the writer node
+	value, err = r1.GetAfter("A", token, time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), value)
+
+	// change is not replicated
+	_, err = r2.GetAfter("A", token, 10*time.Millisecond)
+	assert.Equal(t, ErrTimeout, err)
+
+	result := make(chan int64)
+	go func() {
+		value, err := r2.GetAfter("A", token, time.Second)
+		assert.NoError(t, err)
+		result <- value
+	}()
+
+	// older change does not wake up waiter
+	r2.sync(&SyncRequest{
+		NodeID: "node1",
+		Variables: map[string]*SyncVariable{
+			"A": {NodesValues: map[string]*SyncNodeValue{"node1": {Value: 2, Version: token.Version - 1}}},
+		},
+	})
+
+	select {
+	case <-result:
+		t.Fatal("waiter woke up before change is applied")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	r2.sync(&SyncRequest{
+		NodeID: "node1",
+		Variables: map[string]*SyncVariable{
+			"A": {NodesValues: map[string]*SyncNodeValue{"node1": {Value: 5, Version: token.Version}}},
+		},
+	})
+
+	assert.Equal(t, int64(5), <-result)
+}
+
+func TestRplx_AppliedChanRelease(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	// waiter of never replicated variable does not leak channel
+	_, err := r.GetAfter("A", Token{NodeID: "node2", Version: 1}, time.Millisecond)
+	assert.Equal(t, ErrTimeout, err)
+
+	r.appliedMx.Lock()
+	assert.Len(t, r.applied, 0)
+	r.appliedMx.Unlock()
+
+	ch1, release1 := r.appliedChan("A")
+	ch2, release2 := r.appliedChan("A")
+	assert.Equal(t, ch1, ch2)
+
+	// channel is kept, while any waiter waits
+	release1()
+	r.appliedMx.Lock()
+	assert.Len(t, r.applied, 1)
+	r.appliedMx.Unlock()
+
+	r.notifyApplied("A")
+	<-ch2
+
+	// release after notify does not remove channel of new waiters
+	ch3, release3 := r.appliedChan("A")
+	release2()
+	r.appliedMx.Lock()
+	assert.Len(t, r.applied, 1)
+	r.appliedMx.Unlock()
+
+	release3()
+	r.appliedMx.Lock()
+	assert.Len(t, r.applied, 0)
+	r.appliedMx.Unlock()
+
+	assert.NotEqual(t, ch1, ch3)
+}
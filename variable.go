@@ -12,6 +12,7 @@ const (
 
 type variable struct {
 	name string
+	kind Kind
 
 	// variable value for current node
 	self *variableItem
@@ -19,14 +20,31 @@ type variable struct {
 	ttl        int64
 	ttlVersion int64
 
-	cacheTime     int64
-	cacheValue    int64
+	// overflow policy for Counter kind and overflow state of variable sum
+	overflow   int32
+	overflowed int32
+	// version of overflow policy, policy with the latest version is replicated to all nodes
+	overflowMx      sync.Mutex
+	overflowVersion int64
+
+	// changes increments on each value change, cached value is valid only for the same changes count
+	changes       int64
+	cache         atomic.Value
 	CacheDuration int64
 
 	// variable values for remote nodes
 	// map key - is remove node remoteNodeID
 	remoteItemsMx sync.RWMutex
 	remoteItems   map[string]*variableItem
+
+	// self and remote nodes parts for variable of not Counter kind
+	// remoteParts guarded by remoteItemsMx
+	selfPart    part
+	remoteParts map[string]part
+	newPart     func() part
+
+	// ordered index of members for variable of SortedSet kind
+	sorted *sortedIndex
 }
 
 func newVariable(name string) *variable {
@@ -40,30 +58,147 @@ func newVariable(name string) *variable {
 	return v
 }
 
+// newSyncVariable creates variable for replicated data
+// returns false, if variable kind or kind options are not valid
+func newSyncVariable(name string, sv *SyncVariable) (*variable, bool) {
+	switch sv.Kind {
+	case Kind_Counter:
+		return newVariable(name), true
+	case Kind_Window:
+		if sv.Window == nil || sv.Window.BucketSize <= 0 || sv.Window.Size < sv.Window.BucketSize {
+			return nil, false
+		}
+		return newWindowVariable(name, sv.Window.Size, sv.Window.BucketSize), true
+	case Kind_Period:
+		if sv.Period == nil || !validPeriod(sv.Period.Length, sv.Period.ZoneOffset) {
+			return nil, false
+		}
+		return newPeriodVariable(name, sv.Period.Length, sv.Period.ZoneOffset), true
+	case Kind_Register:
+		return newRegisterVariable(name), true
+	case Kind_Set:
+		return newSetVariable(name), true
+	case Kind_Max, Kind_Min:
+		return newExtremeVariable(name, sv.Kind), true
+	case Kind_Float:
+		return newFloatVariable(name), true
+	case Kind_Bounded:
+		return newBoundedVariable(name), true
+	case Kind_HyperLogLog:
+		return newHLLVariable(name), true
+	case Kind_Histogram:
+		return newHistogramVariable(name), true
+	case Kind_CountMin:
+		return newCountMinVariable(name), true
+	case Kind_Hash:
+		return newHashVariable(name), true
+	case Kind_SortedSet:
+		return newSortedSetVariable(name), true
+	case Kind_Average:
+		return newAverageVariable(name), true
+	case Kind_EnableWinsFlag, Kind_DisableWinsFlag:
+		return newFlagVariable(name, sv.Kind), true
+	case Kind_Sequence:
+		return newSequenceVariable(name), true
+	case Kind_Decay:
+		if sv.Decay == nil || sv.Decay.HalfLife <= 0 {
+			return nil, false
+		}
+		return newDecayVariable(name, sv.Decay.HalfLife), true
+	}
+
+	return nil, false
+}
+
+// syncOptions sets variable kind and kind options for replication
+func (v *variable) syncOptions(sv *SyncVariable) {
+	sv.Kind = v.kind
+
+	switch v.kind {
+	case Kind_Window:
+		sv.Window = v.windowOptions()
+	case Kind_Period:
+		sv.Period = v.periodOptions()
+	case Kind_Counter:
+		sv.Overflow = v.overflowOptions()
+	case Kind_Decay:
+		sv.Decay = v.decayOptions()
+	case Kind_Custom:
+		sv.CustomType = v.customTypeName()
+	}
+}
+
 func (v *variable) partsCount() int {
 	v.remoteItemsMx.RLock()
-	partsCount := len(v.remoteItems)
+	partsCount := len(v.remoteItems) + len(v.remoteParts)
 	v.remoteItemsMx.RUnlock()
 
 	return partsCount
 }
 
+// variableCache describe cached variable value
+type variableCache struct {
+	value    int64
+	overflow bool
+	changes  int64
+	expire   int64
+}
+
 // get returns variable value
 func (v *variable) get() int64 {
-	if atomic.LoadInt64(&v.cacheTime) > time.Now().UTC().Unix() {
-		return atomic.LoadInt64(&v.cacheValue)
+	result, _ := v.value()
+
+	return result
+}
+
+// value returns variable value and true, if value overflows int64
+// value is cached for CacheDuration seconds or until variable is changed
+// cache is invalidated on change, because ratelimit limiters read value right after own Upsert
+func (v *variable) value() (int64, bool) {
+	// value of not Counter kind variable may depend on time
+	if v.kind != Kind_Counter {
+		return v.sum(), false
 	}
 
-	result := v.self.value()
+	changes := atomic.LoadInt64(&v.changes)
 
-	v.remoteItemsMx.RLock()
-	for _, item := range v.remoteItems {
-		result += item.value()
+	if c, ok := v.cache.Load().(*variableCache); ok && c.changes == changes && c.expire > time.Now().UTC().Unix() {
+		return c.value, c.overflow
 	}
-	v.remoteItemsMx.RUnlock()
 
-	atomic.StoreInt64(&v.cacheValue, result)
-	atomic.StoreInt64(&v.cacheTime, time.Now().UTC().Unix()+v.CacheDuration)
+	result, overflow := v.counterSum()
+
+	v.cache.Store(&variableCache{
+		value:    result,
+		overflow: overflow,
+		changes:  changes,
+		expire:   time.Now().UTC().Unix() + v.CacheDuration,
+	})
+
+	return result, overflow
+}
+
+// sum returns variable value without cache
+func (v *variable) sum() int64 {
+	switch v.kind {
+	case Kind_Window:
+		return v.windowSum(time.Now().UTC().UnixNano())
+	case Kind_Period:
+		current, _ := v.periodValues()
+		return current
+	case Kind_Max, Kind_Min:
+		return v.extremeValue()
+	case Kind_Bounded:
+		return v.boundedValue()
+	case Kind_HyperLogLog:
+		return v.hllCount()
+	case Kind_Average:
+		return v.averageMean()
+	case Kind_Register, Kind_Set, Kind_Float, Kind_Histogram, Kind_CountMin, Kind_Hash, Kind_SortedSet, Kind_Decay, Kind_EnableWinsFlag, Kind_DisableWinsFlag, Kind_Custom, Kind_Sequence:
+		return 0
+	}
+
+	result, _ := v.counterSum()
 
 	return result
 }
@@ -77,17 +212,85 @@ func (v *variable) TTLVersion() int64 {
 }
 
 func (v *variable) update(delta int64) int64 {
-	return v.self.update(delta)
+	result := v.self.update(delta)
+	// changes increments after value update, so get never caches old value with new changes count
+	atomic.AddInt64(&v.changes, 1)
+
+	return result
 }
 
 func (v *variable) updateTTL(ttl int64) {
 	atomic.StoreInt64(&v.ttl, ttl)
 	atomic.StoreInt64(&v.ttlVersion, time.Now().UTC().UnixNano())
 	v.self.update(0) // обновляем текущее значение на 0, чтобы обновилась версия переменной и она ушла на репликацию
+	if v.selfPart != nil {
+		v.selfPart.touch()
+	}
+}
+
+// numeric returns true, if variable kind has int64 value
+func (v *variable) numeric() bool {
+	switch v.kind {
+	case Kind_Counter, Kind_Window, Kind_Period, Kind_Max, Kind_Min, Kind_Bounded, Kind_HyperLogLog, Kind_Average:
+		return true
+	}
+
+	return false
+}
+
+// additive returns true, if variable kind supports change value on delta
+func (v *variable) additive() bool {
+	switch v.kind {
+	case Kind_Counter, Kind_Window, Kind_Period:
+		return true
+	}
+
+	return false
+}
+
+// add changes variable value on delta, variable must be additive
+// returns true, if node value of Counter variable overflows, and ErrOverflow, if update was rejected
+func (v *variable) add(delta int64) (bool, error) {
+	switch v.kind {
+	case Kind_Counter:
+		return v.updateCounter(delta)
+	case Kind_Window:
+		v.updateWindow(delta)
+	case Kind_Period:
+		v.updatePeriod(delta)
+	}
+
+	return false, nil
+}
+
+// compactable returns true, if variable kind keeps data, which must be compacted by GC
+func (v *variable) compactable() bool {
+	switch v.kind {
+	case Kind_Set, Kind_EnableWinsFlag, Kind_DisableWinsFlag:
+		return true
+	}
+
+	return false
+}
+
+// compact removes not needed data from self part, returns true if self part was changed
+func (v *variable) compact(localNodeID string, now int64) bool {
+	var changed bool
+
+	switch v.kind {
+	case Kind_Set, Kind_EnableWinsFlag, Kind_DisableWinsFlag:
+		changed = v.compactSet(localNodeID, now)
+	}
+
+	if changed {
+		atomic.AddInt64(&v.changes, 1)
+	}
+
+	return changed
 }
 
 // updateItem updates value for selected node and returns flag: updated or not
-func (v *variable) updateItem(nodeID string, value, version int64) bool {
+func (v *variable) updateItem(nodeID string, value, high, version int64) bool {
 	v.remoteItemsMx.Lock()
 	defer v.remoteItemsMx.Unlock()
 
@@ -101,7 +304,8 @@ func (v *variable) updateItem(nodeID string, value, version int64) bool {
 	}
 
 	if i.version() < version {
-		i.set(value, version)
+		i.setBig(value, high, version)
+		atomic.AddInt64(&v.changes, 1)
 		updated = true
 	}
 
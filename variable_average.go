@@ -0,0 +1,125 @@
+package rplx
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// averagePart is node contribution to variable of Average kind
+// sum and count of samples, added on the node, are changed and replicated together
+type averagePart struct {
+	mx sync.RWMutex
+
+	sum   int64
+	count int64
+	ver   int64
+}
+
+func newAveragePart() *averagePart {
+	return &averagePart{}
+}
+
+// newAverageVariable creates variable of Average kind
+func newAverageVariable(name string) *variable {
+	v := newVariable(name)
+	v.kind = Kind_Average
+	v.newPart = func() part {
+		return newAveragePart()
+	}
+	v.selfPart = v.newPart()
+	v.remoteParts = make(map[string]part)
+
+	return v
+}
+
+func (p *averagePart) add(sum, count int64) {
+	p.mx.Lock()
+	p.sum += sum
+	p.count += count
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+// get returns sum and count of part samples
+func (p *averagePart) get() (int64, int64) {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.sum, p.count
+}
+
+func (p *averagePart) version() int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.ver
+}
+
+func (p *averagePart) touch() {
+	p.mx.Lock()
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *averagePart) reset() {
+	p.mx.Lock()
+	p.sum = 0
+	p.count = 0
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *averagePart) syncValue() *SyncNodeValue {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return &SyncNodeValue{
+		Version: p.ver,
+		Value:   p.sum,
+		Count:   p.count,
+	}
+}
+
+func (p *averagePart) merge(n *SyncNodeValue) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.ver >= n.Version {
+		return false
+	}
+
+	p.sum = n.Value
+	p.count = n.Count
+	p.ver = n.Version
+
+	return true
+}
+
+// averageParts returns sum and count of samples of all nodes
+func (v *variable) averageParts() (int64, int64) {
+	var sum, count int64
+
+	v.eachPart("", func(_ string, p part) {
+		s, c := p.(*averagePart).get()
+		sum += s
+		count += c
+	})
+
+	return sum, count
+}
+
+// averageMean returns mean of samples of all nodes, 0 if there are no samples
+func (v *variable) averageMean() int64 {
+	sum, count := v.averageParts()
+	if count == 0 {
+		return 0
+	}
+
+	return sum / count
+}
+
+// averageAdd adds sum and count of samples to self part
+func (v *variable) averageAdd(sum, count int64) {
+	v.selfPart.(*averagePart).add(sum, count)
+	atomic.AddInt64(&v.changes, 1)
+}
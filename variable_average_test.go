@@ -0,0 +1,65 @@
+package rplx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAveragePart_Merge(t *testing.T) {
+	p := newAveragePart()
+	p.add(10, 2)
+
+	n := p.syncValue()
+	assert.Equal(t, int64(10), n.Value)
+	assert.Equal(t, int64(2), n.Count)
+
+	dst := newAveragePart()
+	assert.True(t, dst.merge(n))
+	assert.False(t, dst.merge(n))
+
+	sum, count := dst.get()
+	assert.Equal(t, int64(10), sum)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestAPI_Average(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	_, err := r.UpsertAverage("A", 10, 0)
+	assert.Equal(t, ErrInvalidAmount, err)
+
+	mean, err := r.UpsertAverage("A", 10, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), mean)
+
+	mean, err = r.UpsertAverage("A", 20, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(15), mean)
+
+	// node2 added 3 samples with sum 90
+	remote := newAveragePart()
+	remote.add(90, 3)
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind:        Kind_Average,
+				NodesValues: map[string]*SyncNodeValue{"node2": remote.syncValue()},
+			},
+		},
+	})
+
+	value, err := r.Get("A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(24), value)
+
+	sum, count, err := r.GetParts("A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(120), sum)
+	assert.Equal(t, int64(5), count)
+
+	r.Upsert("B", 1)
+	_, _, err = r.GetParts("B")
+	assert.Equal(t, ErrVariableKind, err)
+}
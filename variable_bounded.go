@@ -0,0 +1,227 @@
+package rplx
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// boundedPart is node contribution to variable of Bounded kind
+// contains increments and decrements, made on the node, and rights, transferred by the node to other nodes
+// node rights are own increments plus received rights minus own decrements and transferred rights,
+// node decrements only within own rights, so variable value never goes below zero
+type boundedPart struct {
+	mx sync.RWMutex
+
+	inc int64
+	dec int64
+	// map key - node ID, map value - transferred rights
+	transfers map[string]int64
+	ver       int64
+}
+
+func newBoundedPart() *boundedPart {
+	return &boundedPart{
+		transfers: make(map[string]int64),
+	}
+}
+
+// newBoundedVariable creates variable of Bounded kind
+func newBoundedVariable(name string) *variable {
+	v := newVariable(name)
+	v.kind = Kind_Bounded
+	v.newPart = func() part {
+		return newBoundedPart()
+	}
+	v.selfPart = v.newPart()
+	v.remoteParts = make(map[string]part)
+
+	return v
+}
+
+// value returns increments minus decrements of the part
+func (p *boundedPart) value() int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.inc - p.dec
+}
+
+// transferred returns rights, transferred by the part node to node with nodeID
+func (p *boundedPart) transferred(nodeID string) int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.transfers[nodeID]
+}
+
+// rights returns own rights of node with received rights, must be called under lock
+func (p *boundedPart) rights(received int64) int64 {
+	result := p.inc - p.dec + received
+	for _, t := range p.transfers {
+		result -= t
+	}
+
+	return result
+}
+
+func (p *boundedPart) version() int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.ver
+}
+
+func (p *boundedPart) touch() {
+	p.mx.Lock()
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *boundedPart) reset() {
+	p.mx.Lock()
+	p.inc = 0
+	p.dec = 0
+	p.transfers = make(map[string]int64)
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *boundedPart) syncValue() *SyncNodeValue {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	b := &SyncBounded{
+		Increments: p.inc,
+		Decrements: p.dec,
+		Transfers:  make(map[string]int64, len(p.transfers)),
+	}
+
+	for nodeID, t := range p.transfers {
+		b.Transfers[nodeID] = t
+	}
+
+	return &SyncNodeValue{
+		Version: p.ver,
+		Bounded: b,
+	}
+}
+
+func (p *boundedPart) merge(n *SyncNodeValue) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.ver >= n.Version {
+		return false
+	}
+
+	p.inc = 0
+	p.dec = 0
+	p.transfers = make(map[string]int64)
+
+	if n.Bounded != nil {
+		p.inc = n.Bounded.Increments
+		p.dec = n.Bounded.Decrements
+		for nodeID, t := range n.Bounded.Transfers {
+			p.transfers[nodeID] = t
+		}
+	}
+
+	p.ver = n.Version
+
+	return true
+}
+
+// boundedValue returns sum of all nodes parts
+func (v *variable) boundedValue() int64 {
+	var result int64
+
+	v.eachPart("", func(_ string, p part) {
+		result += p.(*boundedPart).value()
+	})
+
+	return result
+}
+
+// boundedReceived returns rights, transferred to local node by remote nodes
+// received rights only grow, so value may be used for checks under self part lock
+func (v *variable) boundedReceived(localNodeID string) int64 {
+	var result int64
+
+	v.remoteItemsMx.RLock()
+	for _, p := range v.remoteParts {
+		result += p.(*boundedPart).transferred(localNodeID)
+	}
+	v.remoteItemsMx.RUnlock()
+
+	return result
+}
+
+// boundedRights returns rights of local node
+func (v *variable) boundedRights(localNodeID string) int64 {
+	received := v.boundedReceived(localNodeID)
+
+	p := v.selfPart.(*boundedPart)
+
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.rights(received)
+}
+
+// boundedIncrement adds delta to increments of self part, delta must be positive
+func (v *variable) boundedIncrement(delta int64) {
+	p := v.selfPart.(*boundedPart)
+
+	p.mx.Lock()
+	p.inc += delta
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+
+	atomic.AddInt64(&v.changes, 1)
+}
+
+// tryDecrement adds delta to decrements of self part, if local node has enough rights
+func (v *variable) tryDecrement(localNodeID string, delta int64) bool {
+	received := v.boundedReceived(localNodeID)
+
+	p := v.selfPart.(*boundedPart)
+
+	p.mx.Lock()
+	if p.rights(received) < delta {
+		p.mx.Unlock()
+		return false
+	}
+	p.dec += delta
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+
+	atomic.AddInt64(&v.changes, 1)
+
+	return true
+}
+
+// grantRights transfers up to amount rights of local node to node with nodeID
+// local node keeps at least half of own rights, returns granted rights
+func (v *variable) grantRights(localNodeID string, nodeID string, amount int64) int64 {
+	received := v.boundedReceived(localNodeID)
+
+	p := v.selfPart.(*boundedPart)
+
+	p.mx.Lock()
+	rights := p.rights(received)
+	granted := rights - rights/2
+	if granted > amount {
+		granted = amount
+	}
+	if granted <= 0 {
+		p.mx.Unlock()
+		return 0
+	}
+	p.transfers[nodeID] += granted
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+
+	atomic.AddInt64(&v.changes, 1)
+
+	return granted
+}
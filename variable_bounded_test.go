@@ -0,0 +1,134 @@
+package rplx
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+)
+
+func TestVariable_BoundedRights(t *testing.T) {
+	v := newBoundedVariable("A")
+	v.boundedIncrement(10)
+
+	assert.True(t, v.tryDecrement("node1", 4))
+	assert.False(t, v.tryDecrement("node1", 7))
+	assert.Equal(t, int64(6), v.boundedRights("node1"))
+
+	// node keeps at least half of own rights
+	assert.Equal(t, int64(3), v.grantRights("node1", "node2", 5))
+	assert.Equal(t, int64(3), v.boundedRights("node1"))
+	assert.Equal(t, int64(1), v.grantRights("node1", "node2", 1))
+
+	// rights, received from node2
+	v.updatePart("node2", &SyncNodeValue{Version: 1, Bounded: &SyncBounded{Increments: 1, Transfers: map[string]int64{"node1": 5}}})
+	assert.Equal(t, int64(7), v.boundedRights("node1"))
+	assert.True(t, v.tryDecrement("node1", 7))
+	assert.False(t, v.tryDecrement("node1", 1))
+
+	assert.Equal(t, int64(0), v.boundedValue())
+}
+
+func TestAPI_BoundedCounter(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	_, err := r.BoundedIncrement("A", 0)
+	assert.Equal(t, ErrInvalidAmount, err)
+
+	value, err := r.BoundedIncrement("A", 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), value)
+
+	ok, err := r.TryDecrement("A", 3)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = r.TryDecrement("A", 3)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	value, err = r.Get("A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), value)
+
+	rights, err := r.LocalRights("A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), rights)
+
+	// bounded variable is not changed by Upsert
+	_, err = r.UpsertChecked("A", -10)
+	assert.Equal(t, ErrVariableKind, err)
+
+	r.Upsert("B", 1)
+	_, err = r.TryDecrement("B", 1)
+	assert.Equal(t, ErrVariableKind, err)
+}
+
+func TestRplx_Rights(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	resp, err := r.Rights(context.Background(), &RightsRequest{NodeID: "node2", Name: "A", Amount: 5})
+	require.NoError(t, err)
+	assert.Equal(t, int64(rightsCodeNotFound), resp.Code)
+
+	_, err = r.BoundedIncrement("A", 10)
+	require.NoError(t, err)
+
+	resp, err = r.Rights(context.Background(), &RightsRequest{NodeID: "node2", Name: "A", Amount: 20})
+	require.NoError(t, err)
+	assert.Equal(t, int64(rightsCodeOK), resp.Code)
+	assert.Equal(t, int64(5), resp.Granted)
+	assert.Equal(t, map[string]int64{"node2": 5}, resp.Value.Bounded.Transfers)
+
+	rights, err := r.LocalRights("A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), rights)
+}
+
+func TestRplx_RequestRights(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := New(WithNodeID("node1"))
+
+	_, err := r.BoundedIncrement("A", 1)
+	require.NoError(t, err)
+
+	mockClient := NewMockReplicatorClient(ctrl)
+	mockClient.EXPECT().Rights(gomock.Any(), &RightsRequest{NodeID: "node1", Name: "A", Amount: 4}).Return(&RightsResponse{
+		Granted: 3,
+		Value: &SyncNodeValue{
+			Version: 1,
+			Bounded: &SyncBounded{Increments: 6, Transfers: map[string]int64{"node1": 3}},
+		},
+	}, nil)
+
+	r.nodesMx.Lock()
+	r.nodes["addr2"] = &node{
+		logger:           zap.NewNop(),
+		connected:        1,
+		localNodeID:      "node1",
+		remoteNodeID:     "node2",
+		replicatorClient: mockClient,
+		replicationChan:  make(chan *variable, 10),
+	}
+	r.nodesMx.Unlock()
+
+	received, err := r.RequestRights(context.Background(), "A", 4)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), received)
+
+	rights, err := r.LocalRights("A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), rights)
+
+	ok, err := r.TryDecrement("A", 4)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	value, err := r.Get("A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), value)
+}
@@ -0,0 +1,266 @@
+package rplx
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// count-min sketch size, estimate error is less than 2 / countMinWidth of total count with probability 1 - 1 / 2^countMinDepth
+	countMinDepth = 4
+	countMinWidth = 2048
+	// count of heavy hitters candidates, kept by each node
+	countMinCandidates = 128
+)
+
+// TopKItem describe item of heavy hitters list
+type TopKItem struct {
+	Item  string
+	Count int64
+}
+
+// countMinPart is node contribution to variable of CountMin kind
+// contains count-min sketch of items, counted on the node, and candidates to heavy hitters
+type countMinPart struct {
+	mx sync.RWMutex
+
+	// nil until the first item is counted
+	cells []int64
+	// map value - node estimate of item
+	candidates map[string]int64
+	ver        int64
+}
+
+func newCountMinPart() *countMinPart {
+	return &countMinPart{
+		candidates: make(map[string]int64),
+	}
+}
+
+// newCountMinVariable creates variable of CountMin kind
+func newCountMinVariable(name string) *variable {
+	v := newVariable(name)
+	v.kind = Kind_CountMin
+	v.newPart = func() part {
+		return newCountMinPart()
+	}
+	v.selfPart = v.newPart()
+	v.remoteParts = make(map[string]part)
+
+	return v
+}
+
+// countMinCells returns sketch cells of item, one cell for each row
+func countMinCells(item string) [countMinDepth]uint32 {
+	x := hashString(item)
+	h1, h2 := uint32(x), uint32(x>>32)
+
+	var result [countMinDepth]uint32
+	for row := uint32(0); row < countMinDepth; row++ {
+		result[row] = row*countMinWidth + (h1+row*h2)%countMinWidth
+	}
+
+	return result
+}
+
+// incr adds delta to item cells and updates candidates, returns node estimate of item
+func (p *countMinPart) incr(item string, delta int64) int64 {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.cells == nil {
+		p.cells = make([]int64, countMinDepth*countMinWidth)
+	}
+
+	var estimate int64
+	for row, cell := range countMinCells(item) {
+		p.cells[cell] += delta
+		if row == 0 || p.cells[cell] < estimate {
+			estimate = p.cells[cell]
+		}
+	}
+
+	p.updateCandidate(item, estimate)
+	p.ver = nextVersion(p.ver)
+
+	return estimate
+}
+
+// updateCandidate puts item to candidates, if candidates are not full or item estimate is greater than minimal
+// must be called under lock
+func (p *countMinPart) updateCandidate(item string, estimate int64) {
+	if _, ok := p.candidates[item]; ok || len(p.candidates) < countMinCandidates {
+		p.candidates[item] = estimate
+		return
+	}
+
+	minItem := ""
+	var minEstimate int64
+	for candidate, e := range p.candidates {
+		if minItem == "" || e < minEstimate {
+			minItem, minEstimate = candidate, e
+		}
+	}
+
+	if estimate > minEstimate {
+		delete(p.candidates, minItem)
+		p.candidates[item] = estimate
+	}
+}
+
+// estimate returns sum of item cells for each row
+func (p *countMinPart) estimate(cells [countMinDepth]uint32, dst *[countMinDepth]int64) {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	if p.cells == nil {
+		return
+	}
+
+	for row, cell := range cells {
+		dst[row] += p.cells[cell]
+	}
+}
+
+// candidatesTo adds part candidates to dst
+func (p *countMinPart) candidatesTo(dst map[string]struct{}) {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	for item := range p.candidates {
+		dst[item] = struct{}{}
+	}
+}
+
+func (p *countMinPart) version() int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.ver
+}
+
+func (p *countMinPart) touch() {
+	p.mx.Lock()
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *countMinPart) reset() {
+	p.mx.Lock()
+	p.cells = nil
+	p.candidates = make(map[string]int64)
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+// syncValue returns not zero cells and candidates
+func (p *countMinPart) syncValue() *SyncNodeValue {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	c := &SyncCountMin{
+		Cells:      make(map[uint32]int64),
+		Candidates: make(map[string]int64, len(p.candidates)),
+	}
+
+	for idx, value := range p.cells {
+		if value != 0 {
+			c.Cells[uint32(idx)] = value
+		}
+	}
+
+	for item, estimate := range p.candidates {
+		c.Candidates[item] = estimate
+	}
+
+	return &SyncNodeValue{
+		Version:  p.ver,
+		CountMin: c,
+	}
+}
+
+// merge replaces part data, cells out of sketch size are ignored
+func (p *countMinPart) merge(n *SyncNodeValue) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.ver >= n.Version {
+		return false
+	}
+
+	p.cells = nil
+	p.candidates = make(map[string]int64)
+
+	if n.CountMin != nil {
+		if len(n.CountMin.Cells) > 0 {
+			p.cells = make([]int64, countMinDepth*countMinWidth)
+		}
+		for idx, value := range n.CountMin.Cells {
+			if idx < countMinDepth*countMinWidth {
+				p.cells[idx] = value
+			}
+		}
+		for item, estimate := range n.CountMin.Candidates {
+			p.candidates[item] = estimate
+		}
+	}
+
+	p.ver = n.Version
+
+	return true
+}
+
+// countMinEstimate returns estimated count of item on all nodes
+func (v *variable) countMinEstimate(item string) int64 {
+	cells := countMinCells(item)
+
+	var rows [countMinDepth]int64
+
+	v.eachPart("", func(_ string, p part) {
+		p.(*countMinPart).estimate(cells, &rows)
+	})
+
+	result := rows[0]
+	for _, value := range rows[1:] {
+		if value < result {
+			result = value
+		}
+	}
+
+	return result
+}
+
+// countMinTopK returns up to n items with the greatest estimated count on all nodes
+// items are taken from candidates of all nodes
+func (v *variable) countMinTopK(n int) []TopKItem {
+	candidates := make(map[string]struct{})
+
+	v.eachPart("", func(_ string, p part) {
+		p.(*countMinPart).candidatesTo(candidates)
+	})
+
+	result := make([]TopKItem, 0, len(candidates))
+	for item := range candidates {
+		result = append(result, TopKItem{Item: item, Count: v.countMinEstimate(item)})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count == result[j].Count {
+			return result[i].Item < result[j].Item
+		}
+		return result[i].Count > result[j].Count
+	})
+
+	if len(result) > n {
+		result = result[:n]
+	}
+
+	return result
+}
+
+// countMinIncr adds delta to item in self part
+func (v *variable) countMinIncr(item string, delta int64) {
+	v.selfPart.(*countMinPart).incr(item, delta)
+	atomic.AddInt64(&v.changes, 1)
+}
@@ -0,0 +1,86 @@
+package rplx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+)
+
+func TestCountMinPart_Candidates(t *testing.T) {
+	p := newCountMinPart()
+
+	for i := 0; i < countMinCandidates; i++ {
+		p.incr("item"+strconv.Itoa(i), 1)
+	}
+
+	// light item does not replace candidates with equal estimate
+	p.incr("light", 1)
+	_, ok := p.candidates["light"]
+	assert.False(t, ok)
+
+	p.incr("heavy", 10)
+	assert.Len(t, p.candidates, countMinCandidates)
+	assert.Equal(t, int64(10), p.candidates["heavy"])
+}
+
+func TestCountMinPart_SyncValue(t *testing.T) {
+	p := newCountMinPart()
+	p.incr("a", 5)
+
+	n := p.syncValue()
+	assert.Len(t, n.CountMin.Cells, countMinDepth)
+	assert.Equal(t, map[string]int64{"a": 5}, n.CountMin.Candidates)
+
+	dst := newCountMinPart()
+	assert.True(t, dst.merge(n))
+	assert.Equal(t, p.cells, dst.cells)
+	assert.False(t, dst.merge(n))
+}
+
+func TestAPI_CountMin(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	_, err := r.Incr("A", "a", 0)
+	assert.Equal(t, ErrInvalidAmount, err)
+
+	for i := 0; i < 200; i++ {
+		_, err = r.Incr("A", "item"+strconv.Itoa(i), 1)
+		require.NoError(t, err)
+	}
+
+	value, err := r.Incr("A", "a", 50)
+	require.NoError(t, err)
+	assert.Equal(t, int64(50), value)
+
+	// node2 counted "b" and "a"
+	remote := newCountMinPart()
+	remote.incr("b", 70)
+	remote.incr("a", 30)
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind:        Kind_CountMin,
+				NodesValues: map[string]*SyncNodeValue{"node2": remote.syncValue()},
+			},
+		},
+	})
+
+	value, err = r.Estimate("A", "a")
+	require.NoError(t, err)
+	assert.Equal(t, int64(80), value)
+
+	top, err := r.TopK("A", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []TopKItem{{Item: "a", Count: 80}, {Item: "b", Count: 70}}, top)
+
+	for _, n := range []int{0, -1} {
+		_, err = r.TopK("A", n)
+		assert.Equal(t, ErrInvalidAmount, err)
+	}
+
+	r.Upsert("B", 1)
+	_, err = r.TopK("B", 1)
+	assert.Equal(t, ErrVariableKind, err)
+}
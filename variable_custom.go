@@ -0,0 +1,186 @@
+package rplx
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// CustomType describe user-defined replicated data type
+// variable of custom type keeps state for each node, state of node is mutated only on this node
+// and is replicated to other nodes with encoded deltas
+type CustomType interface {
+	// NewState returns empty node state
+	NewState() CustomState
+	// Value returns variable value for states of all nodes
+	// states must not be changed or retained after return
+	Value(states []CustomState) interface{}
+}
+
+// CustomState describe node state of user-defined replicated data type
+// calls of state methods are serialized by rplx, so state need not be safe for concurrent use
+type CustomState interface {
+	// Mutate applies local mutation op, version is greater than versions of all previous mutations of the state
+	Mutate(op interface{}, version int64) error
+	// Delta returns encoded changes with version greater than since, since 0 means full state
+	// merged changes must keep own versions, because state of remote node is replicated to other nodes too
+	Delta(since int64) ([]byte, error)
+	// Merge applies encoded changes, returned by Delta of state of the same node
+	// on error state must stay unchanged
+	Merge(delta []byte) error
+}
+
+// customPart is node contribution to variable of Custom kind
+type customPart struct {
+	// mx is shared by all parts of variable, so Value reads consistent states of all nodes
+	mx *sync.RWMutex
+
+	t        CustomType
+	typeName string
+
+	state CustomState
+	// version of the last reset, delta since less version contains full state
+	resetVer int64
+	ver      int64
+}
+
+// newCustomVariable creates variable of Custom kind with registered type t
+func newCustomVariable(name string, typeName string, t CustomType) *variable {
+	mx := &sync.RWMutex{}
+
+	v := newVariable(name)
+	v.kind = Kind_Custom
+	v.newPart = func() part {
+		return &customPart{
+			mx:       mx,
+			t:        t,
+			typeName: typeName,
+			state:    t.NewState(),
+		}
+	}
+	v.selfPart = v.newPart()
+	v.remoteParts = make(map[string]part)
+
+	return v
+}
+
+func (p *customPart) version() int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.ver
+}
+
+func (p *customPart) touch() {
+	p.mx.Lock()
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *customPart) reset() {
+	p.mx.Lock()
+	p.state = p.t.NewState()
+	p.ver = nextVersion(p.ver)
+	p.resetVer = p.ver
+	p.mx.Unlock()
+}
+
+func (p *customPart) syncValue() *SyncNodeValue {
+	return p.syncDelta(0)
+}
+
+// syncDelta returns changes after version since, or full state, if part was reset after since
+// if state can not be encoded, returns value with version since, which is never applied by receiver
+func (p *customPart) syncDelta(since int64) *SyncNodeValue {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	full := since == 0 || p.resetVer > since
+	if full {
+		since = 0
+	}
+
+	payload, err := p.state.Delta(since)
+	if err != nil {
+		return &SyncNodeValue{Version: since}
+	}
+
+	return &SyncNodeValue{
+		Version: p.ver,
+		Payload: payload,
+		Full:    full,
+		Since:   since,
+	}
+}
+
+// merge applies replicated changes, full state replaces part state
+// changes after not applied version are skipped, part is replicated in full state later
+func (p *customPart) merge(n *SyncNodeValue) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.ver >= n.Version || p.ver < n.Since {
+		return false
+	}
+
+	state := p.state
+	if n.Full {
+		state = p.t.NewState()
+	}
+
+	if err := state.Merge(n.Payload); err != nil {
+		return false
+	}
+
+	p.state = state
+	p.ver = n.Version
+
+	return true
+}
+
+// customTypeName returns name of variable type
+func (v *variable) customTypeName() string {
+	return v.selfPart.(*customPart).typeName
+}
+
+// customValue returns variable value for states of all nodes
+func (v *variable) customValue() interface{} {
+	parts := make([]*customPart, 0)
+
+	// parts are collected before lock of states, because updatePart locks states under remoteItemsMx
+	v.eachPart("", func(_ string, p part) {
+		parts = append(parts, p.(*customPart))
+	})
+
+	self := parts[0]
+
+	self.mx.RLock()
+	defer self.mx.RUnlock()
+
+	states := make([]CustomState, 0, len(parts))
+	for _, p := range parts {
+		states = append(states, p.state)
+	}
+
+	return self.t.Value(states)
+}
+
+// customMutate applies local mutation to self part
+func (v *variable) customMutate(op interface{}) error {
+	p := v.selfPart.(*customPart)
+
+	p.mx.Lock()
+	ver := nextVersion(p.ver)
+	err := p.state.Mutate(op, ver)
+	if err == nil {
+		p.ver = ver
+	}
+	p.mx.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	atomic.AddInt64(&v.changes, 1)
+
+	return nil
+}
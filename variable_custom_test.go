@@ -0,0 +1,142 @@
+package rplx
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sort"
+	"testing"
+)
+
+// testGSet is grow-only set of strings, element value is version of add
+type testGSet map[string]int64
+
+type testGSetType struct{}
+
+func (testGSetType) NewState() CustomState {
+	return testGSet{}
+}
+
+func (testGSetType) Value(states []CustomState) interface{} {
+	members := make(map[string]struct{})
+	for _, s := range states {
+		for m := range s.(testGSet) {
+			members[m] = struct{}{}
+		}
+	}
+
+	result := make([]string, 0, len(members))
+	for m := range members {
+		result = append(result, m)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+func (s testGSet) Mutate(op interface{}, version int64) error {
+	m, ok := op.(string)
+	if !ok {
+		return errors.New("bad op")
+	}
+	s[m] = version
+	return nil
+}
+
+func (s testGSet) Delta(since int64) ([]byte, error) {
+	delta := make(map[string]int64)
+	for m, ver := range s {
+		if ver > since {
+			delta[m] = ver
+		}
+	}
+	return json.Marshal(delta)
+}
+
+func (s testGSet) Merge(delta []byte) error {
+	d := make(map[string]int64)
+	if err := json.Unmarshal(delta, &d); err != nil {
+		return err
+	}
+	for m, ver := range d {
+		s[m] = ver
+	}
+	return nil
+}
+
+func TestCustomPart_SyncDelta(t *testing.T) {
+	v := newCustomVariable("A", "gset", testGSetType{})
+	p := v.selfPart.(*customPart)
+
+	require.NoError(t, v.customMutate("a"))
+	full := p.syncValue()
+	assert.True(t, full.Full)
+
+	require.NoError(t, v.customMutate("b"))
+	delta := p.syncDelta(full.Version)
+	assert.False(t, delta.Full)
+	assert.Equal(t, `{"b":`, string(delta.Payload[:5]))
+
+	// node without base version skips changes
+	dst := v.newPart().(*customPart)
+	assert.Equal(t, full.Version, delta.Since)
+	assert.False(t, dst.merge(delta))
+
+	assert.True(t, dst.merge(full))
+	assert.True(t, dst.merge(delta))
+	assert.False(t, dst.merge(delta))
+	assert.Equal(t, p.state, dst.state)
+
+	// delta after reset contains full state and replaces state
+	p.reset()
+	require.NoError(t, v.customMutate("c"))
+	delta = p.syncDelta(delta.Version)
+	assert.True(t, delta.Full)
+	assert.True(t, dst.merge(delta))
+	assert.Equal(t, p.state, dst.state)
+
+	// bad payload is not applied
+	assert.False(t, dst.merge(&SyncNodeValue{Version: delta.Version + 1, Payload: []byte("bad")}))
+}
+
+func TestAPI_Custom(t *testing.T) {
+	r := New(WithNodeID("node1"), WithCustomType("gset", testGSetType{}))
+
+	assert.Equal(t, ErrUnknownType, r.Mutate("A", "unknown", "a"))
+
+	require.NoError(t, r.Mutate("A", "gset", "a"))
+	assert.Error(t, r.Mutate("A", "gset", 1))
+
+	remote := testGSet{}
+	require.NoError(t, remote.Mutate("b", 1))
+	payload, err := remote.Delta(0)
+	require.NoError(t, err)
+
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind:        Kind_Custom,
+				CustomType:  "gset",
+				NodesValues: map[string]*SyncNodeValue{"node2": {Version: 1, Payload: payload, Full: true}},
+			},
+			// not registered type is not created
+			"B": {
+				Kind:        Kind_Custom,
+				CustomType:  "unknown",
+				NodesValues: map[string]*SyncNodeValue{"node2": {Version: 1, Payload: payload, Full: true}},
+			},
+		},
+	})
+
+	value, err := r.GetCustom("A")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, value)
+
+	_, err = r.GetCustom("B")
+	assert.Equal(t, ErrVariableNotExists, err)
+
+	r.Upsert("C", 1)
+	assert.Equal(t, ErrVariableKind, r.Mutate("C", "gset", "a"))
+}
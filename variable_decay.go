@@ -0,0 +1,139 @@
+package rplx
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// decayPart is node contribution to variable of Decay kind
+// contains node value at reference time, value at any time is normalised with half-life,
+// so decay does not change part and does not produce replication traffic
+type decayPart struct {
+	mx sync.RWMutex
+
+	// halfLife in nanoseconds
+	halfLife int64
+
+	value float64
+	// ref - reference time of value in unix nanoseconds
+	ref int64
+	ver int64
+}
+
+func newDecayPart(halfLife int64) *decayPart {
+	return &decayPart{
+		halfLife: halfLife,
+	}
+}
+
+// newDecayVariable creates variable of Decay kind
+func newDecayVariable(name string, halfLife int64) *variable {
+	v := newVariable(name)
+	v.kind = Kind_Decay
+	v.newPart = func() part {
+		return newDecayPart(halfLife)
+	}
+	v.selfPart = v.newPart()
+	v.remoteParts = make(map[string]part)
+
+	return v
+}
+
+// decayed returns value at reference time ref, normalised to time now
+func decayed(value float64, ref, now, halfLife int64) float64 {
+	if value == 0 {
+		return 0
+	}
+
+	return value * math.Exp2(-float64(now-ref)/float64(halfLife))
+}
+
+// update normalises part value to time now and adds delta
+func (p *decayPart) update(delta float64, now int64) {
+	p.mx.Lock()
+	p.value = decayed(p.value, p.ref, now, p.halfLife) + delta
+	p.ref = now
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+// valueAt returns part value, normalised to time now
+func (p *decayPart) valueAt(now int64) float64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return decayed(p.value, p.ref, now, p.halfLife)
+}
+
+func (p *decayPart) version() int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.ver
+}
+
+func (p *decayPart) touch() {
+	p.mx.Lock()
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *decayPart) reset() {
+	p.mx.Lock()
+	p.value = 0
+	p.ref = 0
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *decayPart) syncValue() *SyncNodeValue {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return &SyncNodeValue{
+		Version:   p.ver,
+		Float:     p.value,
+		Timestamp: p.ref,
+	}
+}
+
+func (p *decayPart) merge(n *SyncNodeValue) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.ver >= n.Version {
+		return false
+	}
+
+	p.value = n.Float
+	p.ref = n.Timestamp
+	p.ver = n.Version
+
+	return true
+}
+
+// decaySum returns sum of all nodes parts, normalised to time now
+func (v *variable) decaySum(now int64) float64 {
+	var result float64
+
+	v.eachPart("", func(_ string, p part) {
+		result += p.(*decayPart).valueAt(now)
+	})
+
+	return result
+}
+
+// updateDecay adds delta to self part at the current time
+func (v *variable) updateDecay(delta float64) {
+	v.selfPart.(*decayPart).update(delta, time.Now().UTC().UnixNano())
+	atomic.AddInt64(&v.changes, 1)
+}
+
+// decayOptions returns decay options for replication
+func (v *variable) decayOptions() *SyncDecay {
+	return &SyncDecay{
+		HalfLife: v.selfPart.(*decayPart).halfLife,
+	}
+}
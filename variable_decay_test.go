@@ -0,0 +1,77 @@
+package rplx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDecayPart_Value(t *testing.T) {
+	hl := int64(time.Minute)
+	p := newDecayPart(hl)
+
+	p.update(100, 0)
+	assert.Equal(t, float64(100), p.valueAt(0))
+	assert.InDelta(t, 50, p.valueAt(hl), 1e-9)
+	assert.InDelta(t, 25, p.valueAt(2*hl), 1e-9)
+
+	// delta is added to value, normalised to update time
+	p.update(50, hl)
+	assert.InDelta(t, 100, p.valueAt(hl), 1e-9)
+	assert.InDelta(t, 50, p.valueAt(2*hl), 1e-9)
+
+	// replicated part gives the same value at any time
+	dst := newDecayPart(hl)
+	assert.True(t, dst.merge(p.syncValue()))
+	assert.Equal(t, p.valueAt(3*hl), dst.valueAt(3*hl))
+}
+
+func TestAPI_Decay(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	_, err := r.UpsertDecay("A", 1, 0)
+	assert.Equal(t, ErrInvalidHalfLife, err)
+
+	_, err = r.UpsertDecay("A", math.Inf(1), time.Hour)
+	assert.Equal(t, ErrInvalidValue, err)
+
+	value, err := r.UpsertDecay("A", 10, time.Hour)
+	require.NoError(t, err)
+	assert.InDelta(t, 10, value, 1e-3)
+
+	// node2 added 20 one half-life ago
+	remote := newDecayPart(int64(time.Hour))
+	remote.update(20, time.Now().UTC().Add(-time.Hour).UnixNano())
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind:        Kind_Decay,
+				Decay:       &SyncDecay{HalfLife: int64(time.Hour)},
+				NodesValues: map[string]*SyncNodeValue{"node2": remote.syncValue()},
+			},
+		},
+	})
+
+	value, err = r.GetDecay("A")
+	require.NoError(t, err)
+	assert.InDelta(t, 20, value, 1e-3)
+
+	_, err = r.Get("A")
+	assert.Equal(t, ErrVariableKind, err)
+
+	// variable without options is not created by sync
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"B": {
+				Kind:        Kind_Decay,
+				NodesValues: map[string]*SyncNodeValue{"node2": remote.syncValue()},
+			},
+		},
+	})
+	_, err = r.GetDecay("B")
+	assert.Equal(t, ErrVariableNotExists, err)
+}
@@ -0,0 +1,142 @@
+package rplx
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// extremePart is node contribution to variable of Max or Min kind
+// contains the greatest (Max) or the least (Min) value, written on the node
+type extremePart struct {
+	mx sync.RWMutex
+
+	max   bool
+	value int64
+	set   bool
+	ver   int64
+}
+
+func newExtremePart(max bool) *extremePart {
+	return &extremePart{
+		max: max,
+	}
+}
+
+// newExtremeVariable creates variable of Max or Min kind
+func newExtremeVariable(name string, kind Kind) *variable {
+	v := newVariable(name)
+	v.kind = kind
+	v.newPart = func() part {
+		return newExtremePart(kind == Kind_Max)
+	}
+	v.selfPart = v.newPart()
+	v.remoteParts = make(map[string]part)
+
+	return v
+}
+
+// better returns true, if a replaces b in variable of the part kind
+func (p *extremePart) better(a, b int64) bool {
+	if p.max {
+		return a > b
+	}
+
+	return a < b
+}
+
+// update writes value to part, if value is better than current, returns true if part was changed
+func (p *extremePart) update(value int64) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.set && !p.better(value, p.value) {
+		return false
+	}
+
+	p.value = value
+	p.set = true
+	p.ver = nextVersion(p.ver)
+
+	return true
+}
+
+func (p *extremePart) get() (int64, bool) {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.value, p.set
+}
+
+func (p *extremePart) version() int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.ver
+}
+
+func (p *extremePart) touch() {
+	p.mx.Lock()
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *extremePart) reset() {
+	p.mx.Lock()
+	p.value = 0
+	p.set = false
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *extremePart) syncValue() *SyncNodeValue {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return &SyncNodeValue{
+		Version:  p.ver,
+		Value:    p.value,
+		HasValue: p.set,
+	}
+}
+
+func (p *extremePart) merge(n *SyncNodeValue) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.ver >= n.Version {
+		return false
+	}
+
+	// part of node without written value (after reset or TTL update) has no value
+	p.value = 0
+	if n.HasValue {
+		p.value = n.Value
+	}
+	p.set = n.HasValue
+	p.ver = n.Version
+
+	return true
+}
+
+// extremeValue returns the greatest (Max) or the least (Min) value of all nodes parts, 0 if no values
+func (v *variable) extremeValue() int64 {
+	var result int64
+	found := false
+
+	v.eachPart("", func(_ string, p part) {
+		ep := p.(*extremePart)
+		if value, ok := ep.get(); ok && (!found || ep.better(value, result)) {
+			result = value
+			found = true
+		}
+	})
+
+	return result
+}
+
+// updateExtreme writes value to self part
+func (v *variable) updateExtreme(value int64) {
+	if v.selfPart.(*extremePart).update(value) {
+		atomic.AddInt64(&v.changes, 1)
+	}
+}
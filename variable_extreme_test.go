@@ -0,0 +1,132 @@
+package rplx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestExtremePart_Update(t *testing.T) {
+	max := newExtremePart(true)
+	assert.True(t, max.update(-5))
+	assert.True(t, max.update(10))
+	assert.False(t, max.update(3))
+
+	value, ok := max.get()
+	assert.True(t, ok)
+	assert.Equal(t, int64(10), value)
+
+	min := newExtremePart(false)
+	assert.True(t, min.update(5))
+	assert.True(t, min.update(-10))
+	assert.False(t, min.update(3))
+
+	value, ok = min.get()
+	assert.True(t, ok)
+	assert.Equal(t, int64(-10), value)
+
+	min.reset()
+	_, ok = min.get()
+	assert.False(t, ok)
+}
+
+func TestExtremePart_MergeWithoutValue(t *testing.T) {
+	src := newExtremePart(false)
+	src.touch()
+
+	n := src.syncValue()
+	assert.False(t, n.HasValue)
+
+	dst := newExtremePart(false)
+	assert.True(t, dst.merge(&SyncNodeValue{Version: 1, Value: 50, HasValue: true}))
+	assert.True(t, dst.merge(&SyncNodeValue{Version: n.Version + 1}))
+
+	_, ok := dst.get()
+	assert.False(t, ok)
+}
+
+func TestAPI_UpsertMaxMin(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	value, err := r.UpsertMax("A", 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), value)
+
+	value, err = r.UpsertMax("A", 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), value)
+
+	value, err = r.UpsertMin("B", 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), value)
+
+	value, err = r.UpsertMin("B", 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), value)
+
+	_, err = r.UpsertMin("A", 1)
+	assert.Equal(t, ErrVariableKind, err)
+
+	notExpired, _ := r.All()
+	assert.Equal(t, map[string]int64{"A": 10, "B": 5}, notExpired)
+
+	// expired variable starts from new value
+	require.NoError(t, r.UpdateTTL("A", time.Now().Add(-time.Second)))
+
+	_, err = r.Get("A")
+	assert.Equal(t, ErrVariableExpired, err)
+
+	value, err = r.UpsertMax("A", 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), value)
+}
+
+func TestRplx_SyncMaxMin(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	_, err := r.UpsertMax("A", 10)
+	require.NoError(t, err)
+	_, err = r.UpsertMin("B", 10)
+	require.NoError(t, err)
+
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind: Kind_Max,
+				NodesValues: map[string]*SyncNodeValue{
+					"node2": {Version: 1, Value: 20, HasValue: true},
+					"node3": {Version: 1, Value: 5, HasValue: true},
+				},
+			},
+			"B": {
+				Kind: Kind_Min,
+				NodesValues: map[string]*SyncNodeValue{
+					"node2": {Version: 1, Value: -20, HasValue: true},
+				},
+			},
+			"C": {
+				Kind: Kind_Min,
+				NodesValues: map[string]*SyncNodeValue{
+					"node2": {Version: 1, Value: 7, HasValue: true},
+					// node3 touched variable without value
+					"node3": {Version: 1},
+				},
+			},
+		},
+	})
+
+	value, err := r.Get("A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), value)
+
+	value, err = r.Get("B")
+	require.NoError(t, err)
+	assert.Equal(t, int64(-20), value)
+
+	// self part of replicated variable has no value
+	value, err = r.Get("C")
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), value)
+}
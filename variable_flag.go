@@ -0,0 +1,94 @@
+package rplx
+
+import (
+	"sync/atomic"
+)
+
+// FlagPolicy describe resolution of concurrent enable and disable of flag on different nodes
+type FlagPolicy int
+
+const (
+	// FlagEnableWins - concurrent enable and disable resolve in favor of enable
+	FlagEnableWins FlagPolicy = iota
+	// FlagDisableWins - concurrent enable and disable resolve in favor of disable
+	FlagDisableWins
+)
+
+const (
+	// flag tokens are tags of set members, each enable or disable adds own token and removes observed opposite tokens
+	flagEnable  = "enable"
+	flagDisable = "disable"
+)
+
+// kind returns variable kind for flag policy
+func (p FlagPolicy) kind() Kind {
+	if p == FlagDisableWins {
+		return Kind_DisableWinsFlag
+	}
+
+	return Kind_EnableWinsFlag
+}
+
+// newFlagVariable creates variable of EnableWinsFlag or DisableWinsFlag kind
+// flag parts are the same as for Set kind
+func newFlagVariable(name string, kind Kind) *variable {
+	v := newSetVariable(name)
+	v.kind = kind
+
+	return v
+}
+
+// flag returns true, if variable has flag kind
+func (v *variable) flag() bool {
+	return v.kind == Kind_EnableWinsFlag || v.kind == Kind_DisableWinsFlag
+}
+
+// flagEnabled returns flag state
+// flag is disabled, if there are no enable tokens, concurrent tokens are resolved by variable kind
+func (v *variable) flagEnabled(localNodeID string) bool {
+	if !v.setIsMember(localNodeID, flagEnable) {
+		return false
+	}
+
+	if v.kind == Kind_EnableWinsFlag {
+		return true
+	}
+
+	return !v.setIsMember(localNodeID, flagDisable)
+}
+
+// flagSwitch adds new token to self part and removes all observed opposite tokens in one part change
+// expire is token expiration time in unix nano or 0
+func (v *variable) flagSwitch(localNodeID string, enable bool, expire int64) {
+	add, remove := flagEnable, flagDisable
+	if !enable {
+		add, remove = flagDisable, flagEnable
+	}
+
+	observed := make(map[setTagID]int64)
+
+	v.eachPart(localNodeID, func(nodeID string, p part) {
+		sp := p.(*setPart)
+		sp.mx.RLock()
+		if tag, ok := sp.adds[remove]; ok {
+			observed[setTagID{nodeID: nodeID, seq: tag.seq}] = tag.expire
+		}
+		sp.mx.RUnlock()
+	})
+
+	p := v.selfPart.(*setPart)
+
+	p.mx.Lock()
+	delete(p.adds, remove)
+	for id, exp := range observed {
+		if id.nodeID != localNodeID {
+			p.removes[id] = exp
+		}
+	}
+	p.seq++
+	p.adds[add] = setTag{seq: p.seq, expire: expire}
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+
+	atomic.AddInt64(&v.changes, 1)
+}
@@ -0,0 +1,91 @@
+package rplx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// flagPartSync returns replicated flag part with one token
+func flagPartSync(token string, version int64) *SyncNodeValue {
+	return &SyncNodeValue{
+		Version: version,
+		Set: &SyncSet{
+			Adds: map[string]*SyncSetTag{token: {Seq: 1}},
+			Seq:  1,
+		},
+	}
+}
+
+func TestVariable_FlagConcurrent(t *testing.T) {
+	ew := newFlagVariable("A", Kind_EnableWinsFlag)
+	dw := newFlagVariable("B", Kind_DisableWinsFlag)
+
+	for _, v := range []*variable{ew, dw} {
+		assert.False(t, v.flagEnabled("node1"))
+
+		v.flagSwitch("node1", true, 0)
+		assert.True(t, v.flagEnabled("node1"))
+
+		// node2 disabled flag without observing enable of node1
+		v.updatePart("node2", flagPartSync(flagDisable, 1))
+	}
+
+	assert.True(t, ew.flagEnabled("node1"))
+	assert.False(t, dw.flagEnabled("node1"))
+
+	// disable after observing all tokens disables any flag
+	ew.flagSwitch("node1", false, 0)
+	assert.False(t, ew.flagEnabled("node1"))
+
+	// enable after observing all tokens enables any flag
+	dw.flagSwitch("node1", true, 0)
+	assert.True(t, dw.flagEnabled("node1"))
+}
+
+func TestAPI_Flag(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	ch, cancel := r.Watch("A")
+	defer cancel()
+
+	require.NoError(t, r.EnableFlag("A", FlagDisableWins, 0))
+
+	enabled, err := r.FlagEnabled("A")
+	require.NoError(t, err)
+	assert.True(t, enabled)
+
+	e := <-ch
+	assert.Equal(t, EventUpsert, e.Type)
+	assert.Equal(t, int64(1), e.Value)
+
+	// node2 disabled flag concurrently, disable wins
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind:        Kind_DisableWinsFlag,
+				NodesValues: map[string]*SyncNodeValue{"node2": flagPartSync(flagDisable, 1)},
+			},
+		},
+	})
+
+	e = <-ch
+	assert.Equal(t, EventRemoteUpdate, e.Type)
+	assert.Equal(t, int64(0), e.Value)
+
+	enabled, err = r.FlagEnabled("A")
+	require.NoError(t, err)
+	assert.False(t, enabled)
+
+	// enable with ttl expires
+	require.NoError(t, r.EnableFlag("B", FlagEnableWins, time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+	enabled, err = r.FlagEnabled("B")
+	require.NoError(t, err)
+	assert.False(t, enabled)
+
+	r.Upsert("C", 1)
+	assert.Equal(t, ErrVariableKind, r.DisableFlag("C", FlagEnableWins))
+}
@@ -0,0 +1,107 @@
+package rplx
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// floatPart is node contribution to variable of Float kind
+// contains sum of all float64 deltas, applied on the node
+type floatPart struct {
+	mx sync.RWMutex
+
+	value float64
+	ver   int64
+}
+
+func newFloatPart() *floatPart {
+	return &floatPart{}
+}
+
+// newFloatVariable creates variable of Float kind
+func newFloatVariable(name string) *variable {
+	v := newVariable(name)
+	v.kind = Kind_Float
+	v.newPart = func() part {
+		return newFloatPart()
+	}
+	v.selfPart = v.newPart()
+	v.remoteParts = make(map[string]part)
+
+	return v
+}
+
+func (p *floatPart) update(delta float64) {
+	p.mx.Lock()
+	p.value += delta
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *floatPart) get() float64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.value
+}
+
+func (p *floatPart) version() int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.ver
+}
+
+func (p *floatPart) touch() {
+	p.mx.Lock()
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *floatPart) reset() {
+	p.mx.Lock()
+	p.value = 0
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *floatPart) syncValue() *SyncNodeValue {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return &SyncNodeValue{
+		Version: p.ver,
+		Float:   p.value,
+	}
+}
+
+func (p *floatPart) merge(n *SyncNodeValue) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.ver >= n.Version {
+		return false
+	}
+
+	p.value = n.Float
+	p.ver = n.Version
+
+	return true
+}
+
+// floatSum returns sum of all nodes parts
+func (v *variable) floatSum() float64 {
+	var result float64
+
+	v.eachPart("", func(_ string, p part) {
+		result += p.(*floatPart).get()
+	})
+
+	return result
+}
+
+// updateFloat adds delta to self part
+func (v *variable) updateFloat(delta float64) {
+	v.selfPart.(*floatPart).update(delta)
+	atomic.AddInt64(&v.changes, 1)
+}
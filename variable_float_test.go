@@ -0,0 +1,86 @@
+package rplx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+)
+
+func TestAPI_UpsertFloat(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	value, err := r.UpsertFloat("A", 1.5)
+	require.NoError(t, err)
+	assert.Equal(t, 1.5, value)
+
+	value, err = r.UpsertFloat("A", 0.25)
+	require.NoError(t, err)
+	assert.Equal(t, 1.75, value)
+
+	value, err = r.GetFloat("A")
+	require.NoError(t, err)
+	assert.Equal(t, 1.75, value)
+
+	// float and integer counters can not be mixed up
+	_, err = r.Get("A")
+	assert.Equal(t, ErrVariableKind, err)
+
+	r.Upsert("B", 1)
+	_, err = r.UpsertFloat("B", 1)
+	assert.Equal(t, ErrVariableKind, err)
+	_, err = r.GetFloat("B")
+	assert.Equal(t, ErrVariableKind, err)
+
+	_, err = r.GetFloat("C")
+	assert.Equal(t, ErrVariableNotExists, err)
+
+	for _, delta := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		_, err = r.UpsertFloat("A", delta)
+		assert.Equal(t, ErrInvalidValue, err)
+	}
+
+	value, err = r.GetFloat("A")
+	require.NoError(t, err)
+	assert.Equal(t, 1.75, value)
+}
+
+func TestRplx_SyncFloat(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	_, err := r.UpsertFloat("A", 0.5)
+	require.NoError(t, err)
+
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind: Kind_Float,
+				NodesValues: map[string]*SyncNodeValue{
+					"node2": {Version: 2, Float: 2.25},
+				},
+			},
+		},
+	})
+
+	// old version is ignored
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind: Kind_Float,
+				NodesValues: map[string]*SyncNodeValue{
+					"node2": {Version: 1, Float: 100},
+				},
+			},
+		},
+	})
+
+	value, err := r.GetFloat("A")
+	require.NoError(t, err)
+	assert.Equal(t, 2.75, value)
+
+	self := r.variables["A"].selfPart.syncValue()
+	assert.Equal(t, 0.5, self.Float)
+	assert.Equal(t, int64(0), self.Value)
+}
@@ -0,0 +1,195 @@
+package rplx
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// hashField describe node contribution to hash field with version of the last change
+type hashField struct {
+	value int64
+	ver   int64
+}
+
+// hashPart is node contribution to variable of Hash or SortedSet kind
+// contains node values of hash fields, only fields, changed after the last replicated version, are replicated
+type hashPart struct {
+	mx sync.RWMutex
+
+	fields map[string]hashField
+	// version of the last reset, fields with less version are removed on merge
+	resetVer int64
+	ver      int64
+}
+
+func newHashPart() *hashPart {
+	return &hashPart{
+		fields: make(map[string]hashField),
+	}
+}
+
+// newHashVariable creates variable of Hash kind
+func newHashVariable(name string) *variable {
+	v := newVariable(name)
+	v.kind = Kind_Hash
+	v.newPart = func() part {
+		return newHashPart()
+	}
+	v.selfPart = v.newPart()
+	v.remoteParts = make(map[string]part)
+
+	return v
+}
+
+func (p *hashPart) incr(field string, delta int64) {
+	p.mx.Lock()
+	p.ver = nextVersion(p.ver)
+	f := p.fields[field]
+	p.fields[field] = hashField{value: f.value + delta, ver: p.ver}
+	p.mx.Unlock()
+}
+
+// valuesTo adds part fields values to dst
+func (p *hashPart) valuesTo(dst map[string]int64) {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	for field, f := range p.fields {
+		dst[field] += f.value
+	}
+}
+
+// get returns part value of field
+func (p *hashPart) get(field string) (int64, bool) {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	f, ok := p.fields[field]
+
+	return f.value, ok
+}
+
+// resetVersion returns version of the last part reset
+func (p *hashPart) resetVersion() int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.resetVer
+}
+
+func (p *hashPart) version() int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.ver
+}
+
+func (p *hashPart) touch() {
+	p.mx.Lock()
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *hashPart) reset() {
+	p.mx.Lock()
+	p.fields = make(map[string]hashField)
+	p.ver = nextVersion(p.ver)
+	p.resetVer = p.ver
+	p.mx.Unlock()
+}
+
+func (p *hashPart) syncValue() *SyncNodeValue {
+	return p.syncDelta(0)
+}
+
+// syncDelta returns fields, changed after version since
+func (p *hashPart) syncDelta(since int64) *SyncNodeValue {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	h := &SyncHash{
+		Fields:       make(map[string]*SyncHashField),
+		ResetVersion: p.resetVer,
+	}
+
+	for field, f := range p.fields {
+		if f.ver > since {
+			h.Fields[field] = &SyncHashField{Value: f.value, Version: f.ver}
+		}
+	}
+
+	return &SyncNodeValue{
+		Version: p.ver,
+		Hash:    h,
+		Since:   since,
+	}
+}
+
+// merge applies replicated fields with greater versions
+// changes after not applied version are skipped, part is replicated in full state later
+func (p *hashPart) merge(n *SyncNodeValue) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.ver >= n.Version || p.ver < n.Since {
+		return false
+	}
+
+	if n.Hash != nil {
+		if n.Hash.ResetVersion > p.resetVer {
+			for field, f := range p.fields {
+				if f.ver <= n.Hash.ResetVersion {
+					delete(p.fields, field)
+				}
+			}
+			p.resetVer = n.Hash.ResetVersion
+		}
+
+		for field, f := range n.Hash.Fields {
+			if f != nil && f.Version > p.fields[field].ver {
+				p.fields[field] = hashField{value: f.Value, ver: f.Version}
+			}
+		}
+	}
+
+	p.ver = n.Version
+
+	return true
+}
+
+// hashGet returns sum of all nodes values of field, false if field not exists
+func (v *variable) hashGet(field string) (int64, bool) {
+	var result int64
+	found := false
+
+	v.eachPart("", func(_ string, p part) {
+		if value, ok := p.(*hashPart).get(field); ok {
+			result += value
+			found = true
+		}
+	})
+
+	return result, found
+}
+
+// hashGetAll returns sums of all nodes values of all fields
+func (v *variable) hashGetAll() map[string]int64 {
+	result := make(map[string]int64)
+
+	v.eachPart("", func(_ string, p part) {
+		p.(*hashPart).valuesTo(result)
+	})
+
+	return result
+}
+
+// hashIncr adds delta to field of self part
+func (v *variable) hashIncr(field string, delta int64) {
+	v.selfPart.(*hashPart).incr(field, delta)
+	atomic.AddInt64(&v.changes, 1)
+
+	if v.kind == Kind_SortedSet {
+		v.sorted.markChanged(field)
+		v.updateSortedIndex()
+	}
+}
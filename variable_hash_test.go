@@ -0,0 +1,188 @@
+package rplx
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+)
+
+func TestHashPart_SyncDelta(t *testing.T) {
+	p := newHashPart()
+	p.incr("a", 1)
+	p.incr("b", 2)
+
+	full := p.syncValue()
+	assert.Len(t, full.Hash.Fields, 2)
+
+	p.incr("b", 3)
+
+	// only changed field is replicated
+	delta := p.syncDelta(full.Version)
+	require.Len(t, delta.Hash.Fields, 1)
+	assert.Equal(t, int64(5), delta.Hash.Fields["b"].Value)
+
+	dst := newHashPart()
+	assert.True(t, dst.merge(full))
+	assert.True(t, dst.merge(delta))
+	assert.False(t, dst.merge(delta))
+	assert.Equal(t, p.fields, dst.fields)
+
+	// reset removes fields, not changed after reset
+	p.reset()
+	p.incr("c", 1)
+	assert.True(t, dst.merge(p.syncDelta(delta.Version)))
+	assert.Equal(t, p.fields, dst.fields)
+}
+
+func TestHashPart_MergeMissingBase(t *testing.T) {
+	p := newHashPart()
+	p.incr("a", 1)
+	full := p.syncValue()
+
+	p.incr("b", 2)
+	delta := p.syncDelta(full.Version)
+	assert.Equal(t, full.Version, delta.Since)
+
+	// node without base version skips changes
+	dst := newHashPart()
+	assert.False(t, dst.merge(delta))
+
+	assert.True(t, dst.merge(p.syncValue()))
+	assert.Equal(t, p.fields, dst.fields)
+}
+
+func TestRplx_SyncMissingBase(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	p := newHashPart()
+	p.incr("a", 1)
+	full := p.syncValue()
+	p.incr("b", 2)
+
+	req := &SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind:        Kind_Hash,
+				NodesValues: map[string]*SyncNodeValue{"node2": p.syncDelta(full.Version)},
+			},
+		},
+	}
+
+	resp, err := r.Sync(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"A@node2"}, resp.Resync)
+
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind:        Kind_Hash,
+				NodesValues: map[string]*SyncNodeValue{"node2": full},
+			},
+		},
+	})
+
+	resp, err = r.Sync(context.Background(), req)
+	require.NoError(t, err)
+	assert.Empty(t, resp.Resync)
+}
+
+func TestRplx_SyncSerializedPerSender(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	p := newHashPart()
+	p.incr("a", 1)
+	full := p.syncValue()
+	p.incr("b", 2)
+
+	for _, n := range []*SyncNodeValue{full, p.syncDelta(full.Version)} {
+		resp, err := r.Sync(context.Background(), &SyncRequest{
+			NodeID: "node2",
+			Variables: map[string]*SyncVariable{
+				"A": {
+					Kind:        Kind_Hash,
+					NodesValues: map[string]*SyncNodeValue{"node2": n},
+				},
+			},
+		})
+		require.NoError(t, err)
+		// base version of delta is applied before delta check
+		assert.Empty(t, resp.Resync)
+	}
+}
+
+func TestNode_SyncResync(t *testing.T) {
+	v := newHashVariable("A")
+	v.hashIncr("a", 1)
+
+	client := &replicatorClientMock{}
+	client.On("Sync", mock.Anything, mock.Anything, mock.Anything).Return(&SyncResponse{Resync: []string{"A@node1"}}, nil).Once()
+	client.On("Sync", mock.Anything, mock.Anything, mock.Anything).Return(&SyncResponse{}, nil)
+
+	n := &node{
+		logger:             zap.NewNop(),
+		connected:          1,
+		localNodeID:        "node1",
+		remoteNodeID:       "node2",
+		replicatorClient:   client,
+		buffer:             map[string]*variable{"A": v},
+		replicatedVersions: map[string]int64{"A@node1": 1},
+		metrics:            newMetrics(),
+	}
+
+	require.NoError(t, n.sendSyncRequest())
+
+	// remote node has not base version, variable is sent again with full state
+	assert.NotContains(t, n.replicatedVersions, "A@node1")
+	assert.Contains(t, n.buffer, "A")
+
+	require.NoError(t, n.sendSyncRequest())
+
+	req := client.Calls[1].Arguments.Get(1).(*SyncRequest)
+	assert.Equal(t, int64(0), req.Variables["A"].NodesValues["node1"].Since)
+	assert.Equal(t, v.selfPart.version(), n.replicatedVersions["A@node1"])
+}
+
+func TestAPI_Hash(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	value, err := r.HIncrBy("A", "a", 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), value)
+
+	_, err = r.HIncrBy("A", "b", -1)
+	require.NoError(t, err)
+
+	// node2 incremented "a" and "c"
+	remote := newHashPart()
+	remote.incr("a", 10)
+	remote.incr("c", 3)
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind:        Kind_Hash,
+				NodesValues: map[string]*SyncNodeValue{"node2": remote.syncValue()},
+			},
+		},
+	})
+
+	value, err = r.HGet("A", "a")
+	require.NoError(t, err)
+	assert.Equal(t, int64(15), value)
+
+	_, err = r.HGet("A", "d")
+	assert.Equal(t, ErrFieldNotExists, err)
+
+	all, err := r.HGetAll("A")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"a": 15, "b": -1, "c": 3}, all)
+
+	r.Upsert("B", 1)
+	_, err = r.HIncrBy("B", "a", 1)
+	assert.Equal(t, ErrVariableKind, err)
+}
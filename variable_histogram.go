@@ -0,0 +1,229 @@
+package rplx
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// histogramAccuracy - relative accuracy of quantiles
+	histogramAccuracy = 0.01
+)
+
+var (
+	histogramGamma    = (1 + histogramAccuracy) / (1 - histogramAccuracy)
+	histogramLogGamma = math.Log(histogramGamma)
+)
+
+// HistogramBucket describe histogram bucket with observations in range (Lower, Upper]
+type HistogramBucket struct {
+	Lower float64
+	Upper float64
+	Count int64
+}
+
+// histogramPart is node contribution to variable of Histogram kind
+// observations are counted in logarithmic buckets, bucket i contains values in range (gamma^(i-1), gamma^i]
+type histogramPart struct {
+	mx sync.RWMutex
+
+	buckets map[int32]int64
+	zero    int64
+	ver     int64
+}
+
+func newHistogramPart() *histogramPart {
+	return &histogramPart{
+		buckets: make(map[int32]int64),
+	}
+}
+
+// newHistogramVariable creates variable of Histogram kind
+func newHistogramVariable(name string) *variable {
+	v := newVariable(name)
+	v.kind = Kind_Histogram
+	v.newPart = func() part {
+		return newHistogramPart()
+	}
+	v.selfPart = v.newPart()
+	v.remoteParts = make(map[string]part)
+
+	return v
+}
+
+// histogramIndex returns bucket index for positive value
+func histogramIndex(value float64) int32 {
+	return int32(math.Ceil(math.Log(value) / histogramLogGamma))
+}
+
+func (p *histogramPart) observe(value float64) {
+	p.mx.Lock()
+	if value > 0 {
+		p.buckets[histogramIndex(value)]++
+	} else {
+		p.zero++
+	}
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+// mergeTo adds part buckets to dst and returns count of not positive observations
+func (p *histogramPart) mergeTo(dst map[int32]int64) int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	for idx, count := range p.buckets {
+		dst[idx] += count
+	}
+
+	return p.zero
+}
+
+func (p *histogramPart) version() int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.ver
+}
+
+func (p *histogramPart) touch() {
+	p.mx.Lock()
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *histogramPart) reset() {
+	p.mx.Lock()
+	p.buckets = make(map[int32]int64)
+	p.zero = 0
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *histogramPart) syncValue() *SyncNodeValue {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	h := &SyncHistogram{
+		Buckets: make(map[int32]int64, len(p.buckets)),
+		Zero:    p.zero,
+	}
+
+	for idx, count := range p.buckets {
+		h.Buckets[idx] = count
+	}
+
+	return &SyncNodeValue{
+		Version:   p.ver,
+		Histogram: h,
+	}
+}
+
+func (p *histogramPart) merge(n *SyncNodeValue) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.ver >= n.Version {
+		return false
+	}
+
+	p.buckets = make(map[int32]int64)
+	p.zero = 0
+
+	if n.Histogram != nil {
+		for idx, count := range n.Histogram.Buckets {
+			p.buckets[idx] = count
+		}
+		p.zero = n.Histogram.Zero
+	}
+
+	p.ver = n.Version
+
+	return true
+}
+
+// histogram describe merged histogram of all nodes
+type histogram struct {
+	// sorted bucket indexes
+	indexes []int32
+	buckets map[int32]int64
+	zero    int64
+	count   int64
+}
+
+// histogram returns merge of all nodes parts
+func (v *variable) histogram() *histogram {
+	h := &histogram{
+		buckets: make(map[int32]int64),
+	}
+
+	v.eachPart("", func(_ string, p part) {
+		h.zero += p.(*histogramPart).mergeTo(h.buckets)
+	})
+
+	h.count = h.zero
+	h.indexes = make([]int32, 0, len(h.buckets))
+	for idx, count := range h.buckets {
+		h.indexes = append(h.indexes, idx)
+		h.count += count
+	}
+	sort.Slice(h.indexes, func(i, j int) bool { return h.indexes[i] < h.indexes[j] })
+
+	return h
+}
+
+// quantile returns value for quantile q in range [0, 1], 0 for empty histogram
+// value of bucket is the middle of bucket range, with relative error histogramAccuracy
+func (h *histogram) quantile(q float64) float64 {
+	if h.count == 0 {
+		return 0
+	}
+
+	rank := int64(q * float64(h.count-1))
+
+	if rank < h.zero {
+		return 0
+	}
+
+	seen := h.zero
+	for _, idx := range h.indexes {
+		seen += h.buckets[idx]
+		if seen > rank {
+			return histogramValue(idx)
+		}
+	}
+
+	return histogramValue(h.indexes[len(h.indexes)-1])
+}
+
+// histogramValue returns value with the least relative error for all values of bucket
+func histogramValue(idx int32) float64 {
+	return 2 * math.Pow(histogramGamma, float64(idx)) / (histogramGamma + 1)
+}
+
+// list returns not empty buckets, ordered by bounds, not positive observations are in bucket with bounds (-Inf, 0]
+func (h *histogram) list() []HistogramBucket {
+	result := make([]HistogramBucket, 0, len(h.indexes)+1)
+
+	if h.zero > 0 {
+		result = append(result, HistogramBucket{Lower: math.Inf(-1), Upper: 0, Count: h.zero})
+	}
+
+	for _, idx := range h.indexes {
+		result = append(result, HistogramBucket{
+			Lower: math.Pow(histogramGamma, float64(idx-1)),
+			Upper: math.Pow(histogramGamma, float64(idx)),
+			Count: h.buckets[idx],
+		})
+	}
+
+	return result
+}
+
+// observe adds value to self part
+func (v *variable) observe(value float64) {
+	v.selfPart.(*histogramPart).observe(value)
+	atomic.AddInt64(&v.changes, 1)
+}
@@ -0,0 +1,86 @@
+package rplx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+)
+
+func TestHistogram_Quantile(t *testing.T) {
+	v := newHistogramVariable("A")
+
+	assert.Equal(t, float64(0), v.histogram().quantile(0.5))
+
+	for i := 1; i <= 1000; i++ {
+		v.observe(float64(i))
+	}
+
+	h := v.histogram()
+	assert.Equal(t, int64(1000), h.count)
+
+	assert.InEpsilon(t, 1, h.quantile(0), histogramAccuracy)
+	assert.InEpsilon(t, 500, h.quantile(0.5), histogramAccuracy)
+	assert.InEpsilon(t, 990, h.quantile(0.99), histogramAccuracy)
+	assert.InEpsilon(t, 1000, h.quantile(1), histogramAccuracy)
+}
+
+func TestHistogram_List(t *testing.T) {
+	v := newHistogramVariable("A")
+	v.observe(-1)
+	v.observe(0)
+	v.observe(1)
+	v.observe(1)
+
+	buckets := v.histogram().list()
+	require.Len(t, buckets, 2)
+
+	assert.Equal(t, HistogramBucket{Lower: math.Inf(-1), Upper: 0, Count: 2}, buckets[0])
+	assert.Equal(t, int64(2), buckets[1].Count)
+	assert.True(t, buckets[1].Lower < 1 && buckets[1].Upper >= 1)
+}
+
+func TestAPI_Histogram(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	assert.Equal(t, ErrInvalidValue, r.Observe("A", math.NaN()))
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, r.Observe("A", 10))
+	}
+
+	// node2 observed 10 values 1000
+	remote := newHistogramPart()
+	for i := 0; i < 10; i++ {
+		remote.observe(1000)
+	}
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind:        Kind_Histogram,
+				NodesValues: map[string]*SyncNodeValue{"node2": remote.syncValue()},
+			},
+		},
+	})
+
+	q, err := r.Quantile("A", 0.25)
+	require.NoError(t, err)
+	assert.InEpsilon(t, 10, q, histogramAccuracy)
+
+	q, err = r.Quantile("A", 0.75)
+	require.NoError(t, err)
+	assert.InEpsilon(t, 1000, q, histogramAccuracy)
+
+	_, err = r.Quantile("A", 2)
+	assert.Equal(t, ErrInvalidQuantile, err)
+
+	buckets, err := r.Buckets("A")
+	require.NoError(t, err)
+	require.Len(t, buckets, 2)
+	assert.Equal(t, int64(10), buckets[0].Count)
+	assert.Equal(t, int64(10), buckets[1].Count)
+
+	_, err = r.Get("A")
+	assert.Equal(t, ErrVariableKind, err)
+}
@@ -0,0 +1,238 @@
+package rplx
+
+import (
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// hllPrecision - count of index bits, standard error is 1.04 / sqrt(2^hllPrecision), about 0.8%
+	hllPrecision = 14
+	hllRegisters = 1 << hllPrecision
+)
+
+// hllPart is node contribution to variable of HyperLogLog kind
+// contains registers of items, added on the node, registers of all nodes are merged by max on read
+type hllPart struct {
+	mx sync.RWMutex
+
+	// nil until the first item is added
+	registers []uint8
+	ver       int64
+}
+
+func newHLLPart() *hllPart {
+	return &hllPart{}
+}
+
+// newHLLVariable creates variable of HyperLogLog kind
+func newHLLVariable(name string) *variable {
+	v := newVariable(name)
+	v.kind = Kind_HyperLogLog
+	v.newPart = func() part {
+		return newHLLPart()
+	}
+	v.selfPart = v.newPart()
+	v.remoteParts = make(map[string]part)
+
+	return v
+}
+
+// hashString returns 64 bit hash of item
+func hashString(item string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(item))
+	x := h.Sum64()
+
+	// fnv has weak high bits for short strings, mix it with murmur3 finalizer
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+
+	return x
+}
+
+// hllPosition returns register index and rank for hash
+func hllPosition(x uint64) (uint32, uint8) {
+	idx := uint32(x >> (64 - hllPrecision))
+	rank := uint8(bits.LeadingZeros64(x<<hllPrecision|1<<(hllPrecision-1))) + 1
+
+	return idx, rank
+}
+
+// add adds items to registers, returns true, if any register was changed
+func (p *hllPart) add(items ...string) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	changed := false
+
+	for _, item := range items {
+		idx, rank := hllPosition(hashString(item))
+		if p.registers == nil {
+			p.registers = make([]uint8, hllRegisters)
+		}
+		if rank > p.registers[idx] {
+			p.registers[idx] = rank
+			changed = true
+		}
+	}
+
+	if changed {
+		p.ver = nextVersion(p.ver)
+	}
+
+	return changed
+}
+
+// maxTo writes register-wise max of part and dst registers to dst
+func (p *hllPart) maxTo(dst []uint8) {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	for idx, rank := range p.registers {
+		if rank > dst[idx] {
+			dst[idx] = rank
+		}
+	}
+}
+
+func (p *hllPart) version() int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.ver
+}
+
+func (p *hllPart) touch() {
+	p.mx.Lock()
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *hllPart) reset() {
+	p.mx.Lock()
+	p.registers = nil
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+// syncValue returns registers in sparse encoding, if less than quarter of registers are not zero
+func (p *hllPart) syncValue() *SyncNodeValue {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	h := &SyncHyperLogLog{}
+
+	count := 0
+	for _, rank := range p.registers {
+		if rank > 0 {
+			count++
+		}
+	}
+
+	if count > hllRegisters/4 {
+		h.Registers = append([]byte(nil), p.registers...)
+	} else {
+		h.SparseIndexes = make([]uint32, 0, count)
+		h.SparseValues = make([]byte, 0, count)
+		for idx, rank := range p.registers {
+			if rank > 0 {
+				h.SparseIndexes = append(h.SparseIndexes, uint32(idx))
+				h.SparseValues = append(h.SparseValues, rank)
+			}
+		}
+	}
+
+	return &SyncNodeValue{
+		Version:     p.ver,
+		HyperLogLog: h,
+	}
+}
+
+// merge replaces registers with replicated registers of the newer version, data with wrong size is ignored
+// registers of node are replicated as whole, so reset of part on node clears the registers
+func (p *hllPart) merge(n *SyncNodeValue) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.ver >= n.Version {
+		return false
+	}
+
+	p.ver = n.Version
+	p.registers = nil
+
+	h := n.HyperLogLog
+	if h == nil {
+		return true
+	}
+
+	if len(h.Registers) == hllRegisters {
+		p.registers = append([]uint8(nil), h.Registers...)
+	}
+
+	if len(h.SparseIndexes) > 0 && len(h.SparseIndexes) == len(h.SparseValues) {
+		if p.registers == nil {
+			p.registers = make([]uint8, hllRegisters)
+		}
+		for i, idx := range h.SparseIndexes {
+			if idx < hllRegisters && h.SparseValues[i] > p.registers[idx] {
+				p.registers[idx] = h.SparseValues[i]
+			}
+		}
+	}
+
+	return true
+}
+
+// hllAdd adds items to self part, returns true, if self part was changed
+func (v *variable) hllAdd(items ...string) bool {
+	if !v.selfPart.(*hllPart).add(items...) {
+		return false
+	}
+
+	atomic.AddInt64(&v.changes, 1)
+
+	return true
+}
+
+// hllCount returns estimated count of distinct items, added on all nodes
+func (v *variable) hllCount() int64 {
+	registers := make([]uint8, hllRegisters)
+
+	v.eachPart("", func(_ string, p part) {
+		p.(*hllPart).maxTo(registers)
+	})
+
+	return hllEstimate(registers)
+}
+
+// hllEstimate returns HyperLogLog estimate with linear counting for small cardinalities
+func hllEstimate(registers []uint8) int64 {
+	m := float64(len(registers))
+
+	var sum float64
+	zeros := 0
+
+	for _, rank := range registers {
+		sum += math.Ldexp(1, -int(rank))
+		if rank == 0 {
+			zeros++
+		}
+	}
+
+	alpha := 0.7213 / (1 + 1.079/m)
+	estimate := alpha * m * m / sum
+
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+
+	return int64(estimate + 0.5)
+}
@@ -0,0 +1,90 @@
+package rplx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+)
+
+func TestHLLEstimate(t *testing.T) {
+	for _, count := range []int{10, 1000, 100000} {
+		p := newHLLPart()
+		for i := 0; i < count; i++ {
+			p.add("item" + strconv.Itoa(i))
+		}
+
+		estimate := hllEstimate(p.registers)
+
+		assert.InEpsilon(t, count, estimate, 0.03, "count %d", count)
+	}
+
+	assert.Equal(t, int64(0), hllEstimate(make([]uint8, hllRegisters)))
+}
+
+func TestHLLPart_SyncValue(t *testing.T) {
+	p := newHLLPart()
+	p.add("a", "b", "c")
+
+	sparse := p.syncValue()
+	assert.Len(t, sparse.HyperLogLog.Registers, 0)
+	assert.Len(t, sparse.HyperLogLog.SparseIndexes, 3)
+
+	dst := newHLLPart()
+	assert.True(t, dst.merge(sparse))
+	assert.Equal(t, p.registers, dst.registers)
+
+	for i := 0; i < 20000; i++ {
+		p.add("item" + strconv.Itoa(i))
+	}
+
+	dense := p.syncValue()
+	assert.Len(t, dense.HyperLogLog.Registers, hllRegisters)
+	assert.Len(t, dense.HyperLogLog.SparseIndexes, 0)
+
+	assert.True(t, dst.merge(dense))
+	assert.Equal(t, p.registers, dst.registers)
+	assert.False(t, dst.merge(dense))
+
+	// reset of node part clears replicated registers
+	p.reset()
+	assert.True(t, dst.merge(p.syncValue()))
+	assert.Nil(t, dst.registers)
+}
+
+func TestAPI_HyperLogLog(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	changed, err := r.PFAdd("A", "a", "b", "c")
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	changed, err = r.PFAdd("A", "a")
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	// node2 added items "c" and "d"
+	remote := newHLLPart()
+	remote.add("c", "d")
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind:        Kind_HyperLogLog,
+				NodesValues: map[string]*SyncNodeValue{"node2": remote.syncValue()},
+			},
+		},
+	})
+
+	count, err := r.PFCount("A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+
+	value, err := r.Get("A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), value)
+
+	r.Upsert("B", 1)
+	_, err = r.PFAdd("B", "a")
+	assert.Equal(t, ErrVariableKind, err)
+}
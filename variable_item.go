@@ -1,13 +1,18 @@
 package rplx
 
 import (
+	"sync"
 	"sync/atomic"
 	"time"
 )
 
 type variableItem struct {
+	// mx serializes changes of the item, so snapshot returns value, high bits and version of the same change
+	mx  sync.Mutex
 	val int64
 	ver int64
+	// high 64 bits of value, used by OverflowBig policy
+	hi int64
 }
 
 func newVariableItem() *variableItem {
@@ -15,15 +20,43 @@ func newVariableItem() *variableItem {
 }
 
 func (item *variableItem) update(delta int64) int64 {
+	item.mx.Lock()
+	defer item.mx.Unlock()
+
 	atomic.StoreInt64(&item.ver, time.Now().UTC().UnixNano())
 	return atomic.AddInt64(&item.val, delta)
 }
 
 func (item *variableItem) set(value, version int64) {
+	item.mx.Lock()
+	defer item.mx.Unlock()
+
+	atomic.StoreInt64(&item.val, value)
+	atomic.StoreInt64(&item.ver, version)
+}
+
+// setBig sets value with high 64 bits and version
+func (item *variableItem) setBig(value, high, version int64) {
+	item.mx.Lock()
+	defer item.mx.Unlock()
+
 	atomic.StoreInt64(&item.val, value)
+	atomic.StoreInt64(&item.hi, high)
 	atomic.StoreInt64(&item.ver, version)
 }
 
+// snapshot returns value, high 64 bits of value and version of the same change
+func (item *variableItem) snapshot() (value, high, version int64) {
+	item.mx.Lock()
+	defer item.mx.Unlock()
+
+	return item.val, item.hi, item.ver
+}
+
+func (item *variableItem) high() int64 {
+	return atomic.LoadInt64(&item.hi)
+}
+
 func (item *variableItem) value() int64 {
 	return atomic.LoadInt64(&item.val)
 }
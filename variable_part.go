@@ -0,0 +1,133 @@
+package rplx
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// part describe node contribution to variable of not Counter kind
+// part of each node is changed only by this node and replicated to other nodes with version
+type part interface {
+	// version returns part version
+	version() int64
+	// touch updates part version without change data, used for replicate TTL changes
+	touch()
+	// syncValue returns part data for replication
+	syncValue() *SyncNodeValue
+	// merge applies replicated part data, returns true if part was changed
+	merge(n *SyncNodeValue) bool
+	// reset clears part data and updates part version
+	reset()
+}
+
+// deltaPart is part, which can replicate only data, changed after the last replicated version
+type deltaPart interface {
+	part
+	// syncDelta returns part data, changed after version since
+	syncDelta(since int64) *SyncNodeValue
+}
+
+// nextVersion returns new part version, based on current time and greater than current version
+func nextVersion(current int64) int64 {
+	v := time.Now().UTC().UnixNano()
+	if v <= current {
+		return current + 1
+	}
+
+	return v
+}
+
+// updatePart applies replicated data for remote node part and returns flag: updated or not
+func (v *variable) updatePart(nodeID string, n *SyncNodeValue) bool {
+	v.remoteItemsMx.Lock()
+	defer v.remoteItemsMx.Unlock()
+
+	updated := false
+
+	p, ok := v.remoteParts[nodeID]
+	if !ok {
+		p = v.newPart()
+		v.remoteParts[nodeID] = p
+		updated = true
+	}
+
+	var merged bool
+	if v.kind == Kind_SortedSet {
+		merged = v.sorted.mergeSorted(p.(*hashPart), n)
+	} else {
+		merged = p.merge(n)
+	}
+
+	if merged {
+		atomic.AddInt64(&v.changes, 1)
+		updated = true
+	}
+
+	return updated
+}
+
+// updateNodeValue applies replicated data for remote node and returns flag: updated or not
+func (v *variable) updateNodeValue(nodeID string, n *SyncNodeValue) bool {
+	if v.kind == Kind_Counter {
+		return v.updateItem(nodeID, n.Value, n.ValueHigh, n.Version)
+	}
+
+	return v.updatePart(nodeID, n)
+}
+
+// eachPart calls fn for self part with localNodeID and for each remote part
+func (v *variable) eachPart(localNodeID string, fn func(nodeID string, p part)) {
+	fn(localNodeID, v.selfPart)
+
+	v.remoteItemsMx.RLock()
+	for nodeID, p := range v.remoteParts {
+		fn(nodeID, p)
+	}
+	v.remoteItemsMx.RUnlock()
+}
+
+// resetParts clears self part and removes remote parts, used for reuse expired variable
+func (v *variable) resetParts() {
+	v.selfPart.reset()
+
+	v.remoteItemsMx.Lock()
+	v.remoteParts = make(map[string]part)
+	v.remoteItemsMx.Unlock()
+
+	if v.kind == Kind_SortedSet {
+		v.sorted.markRebuild()
+	}
+
+	atomic.AddInt64(&v.changes, 1)
+}
+
+// nodeVersion returns version of node data, applied on local node
+func (v *variable) nodeVersion(nodeID, localNodeID string) int64 {
+	if v.kind == Kind_Counter {
+		if nodeID == localNodeID {
+			return v.self.version()
+		}
+
+		v.remoteItemsMx.RLock()
+		defer v.remoteItemsMx.RUnlock()
+
+		if item, ok := v.remoteItems[nodeID]; ok {
+			return item.version()
+		}
+
+		return 0
+	}
+
+	if nodeID == localNodeID {
+		return v.selfPart.version()
+	}
+
+	v.remoteItemsMx.RLock()
+	defer v.remoteItemsMx.RUnlock()
+
+	if p, ok := v.remoteParts[nodeID]; ok {
+		return p.version()
+	}
+
+	return 0
+}
@@ -0,0 +1,236 @@
+package rplx
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// PeriodHourly - period counter resets every hour
+	PeriodHourly = PeriodLength_Hourly
+	// PeriodDaily - period counter resets every day
+	PeriodDaily = PeriodLength_Daily
+	// PeriodMonthly - period counter resets every month
+	PeriodMonthly = PeriodLength_Monthly
+
+	// periodGrace is time after the period boundary, while the previous period is replicated
+	// after grace time the previous period value is frozen on each node
+	periodGrace = defaultRemoteNodeSyncInterval * 15
+)
+
+// periodPart is node contribution to variable of Period kind
+// contains values for the current and the previous periods
+type periodPart struct {
+	mx sync.RWMutex
+
+	length     PeriodLength
+	zoneOffset int64
+	zone       *time.Location
+
+	// map key - period start time in unix seconds
+	buckets map[int64]int64
+	ver     int64
+
+	now func() time.Time
+}
+
+func newPeriodPart(length PeriodLength, zoneOffset int64) *periodPart {
+	return &periodPart{
+		length:     length,
+		zoneOffset: zoneOffset,
+		zone:       time.FixedZone("", int(zoneOffset)),
+		buckets:    make(map[int64]int64),
+		now:        time.Now,
+	}
+}
+
+// newPeriodVariable creates variable of Period kind
+func newPeriodVariable(name string, length PeriodLength, zoneOffset int64) *variable {
+	v := newVariable(name)
+	v.kind = Kind_Period
+	v.newPart = func() part {
+		return newPeriodPart(length, zoneOffset)
+	}
+	v.selfPart = v.newPart()
+	v.remoteParts = make(map[string]part)
+
+	return v
+}
+
+// validPeriod returns true, if period length and zone offset are valid
+func validPeriod(length PeriodLength, zoneOffset int64) bool {
+	if _, ok := PeriodLength_name[int32(length)]; !ok {
+		return false
+	}
+
+	return zoneOffset > -24*3600 && zoneOffset < 24*3600
+}
+
+// periods returns start times of the period, contains time now, and the previous period
+func (p *periodPart) periods(now time.Time) (current, previous int64) {
+	t := now.In(p.zone)
+
+	var start, prev time.Time
+
+	switch p.length {
+	case PeriodLength_Hourly:
+		start = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, p.zone)
+		prev = start.Add(-time.Hour)
+	case PeriodLength_Daily:
+		start = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, p.zone)
+		prev = start.AddDate(0, 0, -1)
+	default:
+		start = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, p.zone)
+		prev = start.AddDate(0, -1, 0)
+	}
+
+	return start.Unix(), prev.Unix()
+}
+
+// inGrace returns true, if the previous period is not frozen yet
+func inGrace(now time.Time, current int64) bool {
+	return now.Before(time.Unix(current, 0).Add(periodGrace))
+}
+
+// prune removes buckets of closed periods, except the previous period, must be called under lock
+func (p *periodPart) prune(previous int64) {
+	for start := range p.buckets {
+		if start < previous {
+			delete(p.buckets, start)
+		}
+	}
+}
+
+func (p *periodPart) update(delta int64) {
+	current, previous := p.periods(p.now())
+
+	p.mx.Lock()
+	p.prune(previous)
+	p.buckets[current] += delta
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+// value returns value for period with start time
+func (p *periodPart) value(start int64) int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.buckets[start]
+}
+
+func (p *periodPart) version() int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.ver
+}
+
+func (p *periodPart) touch() {
+	p.mx.Lock()
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *periodPart) reset() {
+	p.mx.Lock()
+	p.buckets = make(map[int64]int64)
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *periodPart) syncValue() *SyncNodeValue {
+	now := p.now()
+	current, previous := p.periods(now)
+	grace := inGrace(now, current)
+
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	n := &SyncNodeValue{
+		Version: p.ver,
+		Buckets: make(map[int64]int64),
+	}
+
+	// the previous period is replicated while grace time, values of other closed periods are not replicated
+	for start, value := range p.buckets {
+		if start == current || (grace && start == previous) {
+			n.Buckets[start] = value
+		}
+	}
+
+	return n
+}
+
+// merge applies values of the current period and values of the previous period while grace time
+// late contributions to frozen periods and values of future periods are ignored
+func (p *periodPart) merge(n *SyncNodeValue) bool {
+	now := p.now()
+	current, previous := p.periods(now)
+	grace := inGrace(now, current)
+
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.ver >= n.Version {
+		return false
+	}
+
+	buckets := make(map[int64]int64)
+
+	// frozen value of the previous period stays as is
+	if !grace {
+		if value, ok := p.buckets[previous]; ok {
+			buckets[previous] = value
+		}
+	}
+
+	for start, value := range n.Buckets {
+		if start == current || (grace && start == previous) {
+			buckets[start] = value
+		}
+	}
+
+	p.buckets = buckets
+	p.ver = n.Version
+
+	return true
+}
+
+// periodSum returns sum of all nodes parts for period with start time
+func (v *variable) periodSum(start int64) int64 {
+	result := v.selfPart.(*periodPart).value(start)
+
+	v.remoteItemsMx.RLock()
+	for _, p := range v.remoteParts {
+		result += p.(*periodPart).value(start)
+	}
+	v.remoteItemsMx.RUnlock()
+
+	return result
+}
+
+// periodValues returns variable values for the current and the previous periods
+func (v *variable) periodValues() (current, previous int64) {
+	p := v.selfPart.(*periodPart)
+	currentStart, previousStart := p.periods(p.now())
+
+	return v.periodSum(currentStart), v.periodSum(previousStart)
+}
+
+// updatePeriod adds delta to the current period of self part
+func (v *variable) updatePeriod(delta int64) {
+	v.selfPart.(*periodPart).update(delta)
+	atomic.AddInt64(&v.changes, 1)
+}
+
+// periodOptions returns period options for replication
+func (v *variable) periodOptions() *SyncPeriod {
+	p := v.selfPart.(*periodPart)
+
+	return &SyncPeriod{
+		Length:     p.length,
+		ZoneOffset: p.zoneOffset,
+	}
+}
@@ -0,0 +1,133 @@
+package rplx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestPeriodPart_Periods(t *testing.T) {
+	now := time.Date(2020, 3, 1, 1, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		length     PeriodLength
+		zoneOffset int64
+		current    time.Time
+		previous   time.Time
+	}{
+		{"hourly UTC", PeriodHourly, 0, time.Date(2020, 3, 1, 1, 0, 0, 0, time.UTC), time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"daily UTC", PeriodDaily, 0, time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"daily UTC-3", PeriodDaily, -3 * 3600, time.Date(2020, 2, 29, 3, 0, 0, 0, time.UTC), time.Date(2020, 2, 28, 3, 0, 0, 0, time.UTC)},
+		{"monthly UTC", PeriodMonthly, 0, time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"monthly UTC+5", PeriodMonthly, 5 * 3600, time.Date(2020, 2, 29, 19, 0, 0, 0, time.UTC), time.Date(2020, 1, 31, 19, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, previous := newPeriodPart(tt.length, tt.zoneOffset).periods(now)
+			assert.Equal(t, tt.current.Unix(), current)
+			assert.Equal(t, tt.previous.Unix(), previous)
+		})
+	}
+}
+
+func TestPeriodPart_MergeIgnoresClosedPeriods(t *testing.T) {
+	p := newPeriodPart(PeriodHourly, 0)
+	current, previous := p.periods(time.Now())
+	p.now = func() time.Time { return time.Unix(current, 0).Add(periodGrace + time.Second) }
+
+	p.buckets[previous] = 10
+	p.ver = 1
+
+	assert.True(t, p.merge(&SyncNodeValue{
+		Version: 2,
+		Buckets: map[int64]int64{
+			current:          5,
+			previous:         20,
+			previous - 3600:  30,
+			current + 3600*2: 1,
+		},
+	}))
+
+	assert.Equal(t, map[int64]int64{current: 5, previous: 10}, p.buckets)
+	assert.False(t, p.merge(&SyncNodeValue{Version: 2}))
+
+	// the previous period is not replicated after grace time
+	assert.Equal(t, map[int64]int64{current: 5}, p.syncValue().Buckets)
+}
+
+func TestPeriodPart_MergePreviousInGrace(t *testing.T) {
+	p := newPeriodPart(PeriodHourly, 0)
+	current, previous := p.periods(time.Now())
+	p.now = func() time.Time { return time.Unix(current, 0).Add(time.Second) }
+
+	p.buckets[previous] = 10
+	p.ver = 1
+
+	assert.True(t, p.merge(&SyncNodeValue{
+		Version: 2,
+		Buckets: map[int64]int64{
+			current:         5,
+			previous:        20,
+			previous - 3600: 30,
+		},
+	}))
+
+	assert.Equal(t, map[int64]int64{current: 5, previous: 20}, p.buckets)
+	assert.Equal(t, map[int64]int64{current: 5, previous: 20}, p.syncValue().Buckets)
+}
+
+func TestAPI_UpsertPeriod(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	_, err := r.UpsertPeriod("A", 1, PeriodLength(10), 0)
+	assert.Equal(t, ErrInvalidPeriod, err)
+
+	value, err := r.UpsertPeriod("A", 10, PeriodDaily, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), value)
+
+	// time after grace of the current period
+	now := func() time.Time { return time.Now().UTC().Truncate(time.Hour * 24).Add(time.Hour) }
+	v := r.variables["A"]
+	v.selfPart.(*periodPart).now = now
+	v.newPart = func() part {
+		p := newPeriodPart(PeriodDaily, 0)
+		p.now = now
+		return p
+	}
+
+	assert.Equal(t, int64(15), r.Upsert("A", 5))
+
+	// move current values to the previous period
+	p := v.selfPart.(*periodPart)
+	current, previous := p.periods(now())
+	p.buckets = map[int64]int64{previous: p.buckets[current]}
+
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind:   Kind_Period,
+				Period: &SyncPeriod{Length: PeriodDaily},
+				NodesValues: map[string]*SyncNodeValue{
+					"node2": {Version: 1, Buckets: map[int64]int64{current: 7, previous: 100}},
+				},
+			},
+		},
+	})
+
+	got, err := r.Get("A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), got)
+
+	got, err = r.GetPrevious("A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(15), got)
+
+	r.Upsert("B", 1)
+	_, err = r.GetPrevious("B")
+	assert.Equal(t, ErrVariableKind, err)
+}
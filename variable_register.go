@@ -0,0 +1,134 @@
+package rplx
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// registerPart is node contribution to variable of Register kind
+// contains the last value, written on the node, and write timestamp
+type registerPart struct {
+	mx sync.RWMutex
+
+	value []byte
+	ts    int64
+	ver   int64
+}
+
+func newRegisterPart() *registerPart {
+	return &registerPart{}
+}
+
+// newRegisterVariable creates variable of Register kind
+func newRegisterVariable(name string) *variable {
+	v := newVariable(name)
+	v.kind = Kind_Register
+	v.newPart = func() part {
+		return newRegisterPart()
+	}
+	v.selfPart = v.newPart()
+	v.remoteParts = make(map[string]part)
+
+	return v
+}
+
+func (p *registerPart) set(value []byte, ts int64) {
+	p.mx.Lock()
+	p.value = append([]byte(nil), value...)
+	p.ts = ts
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *registerPart) get() ([]byte, int64) {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.value, p.ts
+}
+
+func (p *registerPart) version() int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.ver
+}
+
+func (p *registerPart) touch() {
+	p.mx.Lock()
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *registerPart) reset() {
+	p.mx.Lock()
+	p.value = nil
+	p.ts = 0
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *registerPart) syncValue() *SyncNodeValue {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return &SyncNodeValue{
+		Version: p.ver,
+		Register: &SyncRegister{
+			Value:     p.value,
+			Timestamp: p.ts,
+		},
+	}
+}
+
+func (p *registerPart) merge(n *SyncNodeValue) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.ver >= n.Version {
+		return false
+	}
+
+	p.value = nil
+	p.ts = 0
+	if n.Register != nil {
+		p.value = append([]byte(nil), n.Register.Value...)
+		p.ts = n.Register.Timestamp
+	}
+	p.ver = n.Version
+
+	return true
+}
+
+// registerValue returns value with the latest write timestamp
+// if timestamps are equal, value of node with greater node ID wins
+func (v *variable) registerValue(localNodeID string) []byte {
+	var result []byte
+	var resultTS int64
+	var resultNodeID string
+
+	v.eachPart(localNodeID, func(nodeID string, p part) {
+		value, ts := p.(*registerPart).get()
+		if ts > resultTS || (ts == resultTS && ts > 0 && nodeID > resultNodeID) {
+			result, resultTS, resultNodeID = value, ts, nodeID
+		}
+	})
+
+	return append([]byte(nil), result...)
+}
+
+// setRegister writes value to self part
+// write timestamp is greater than timestamps of all known values, so local write always wins
+func (v *variable) setRegister(value []byte) {
+	ts := time.Now().UTC().UnixNano()
+
+	v.eachPart("", func(_ string, p part) {
+		if _, partTS := p.(*registerPart).get(); partTS >= ts {
+			ts = partTS + 1
+		}
+	})
+
+	v.selfPart.(*registerPart).set(value, ts)
+	atomic.AddInt64(&v.changes, 1)
+}
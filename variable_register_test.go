@@ -0,0 +1,76 @@
+package rplx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestRegisterPart_Merge(t *testing.T) {
+	p := newRegisterPart()
+	p.set([]byte("A"), 100)
+	ver := p.version()
+
+	assert.False(t, p.merge(&SyncNodeValue{Version: ver, Register: &SyncRegister{Value: []byte("B"), Timestamp: 200}}))
+	assert.True(t, p.merge(&SyncNodeValue{Version: ver + 1, Register: &SyncRegister{Value: []byte("C"), Timestamp: 300}}))
+
+	value, ts := p.get()
+	assert.Equal(t, []byte("C"), value)
+	assert.Equal(t, int64(300), ts)
+}
+
+func TestVariable_RegisterValue(t *testing.T) {
+	v := newRegisterVariable("A")
+
+	assert.Nil(t, v.registerValue("node1"))
+
+	v.selfPart.(*registerPart).set([]byte("node1"), 100)
+	v.updatePart("node2", &SyncNodeValue{Version: 1, Register: &SyncRegister{Value: []byte("node2"), Timestamp: 200}})
+	v.updatePart("node3", &SyncNodeValue{Version: 1, Register: &SyncRegister{Value: []byte("node3"), Timestamp: 200}})
+
+	// equal timestamps, greater node ID wins
+	assert.Equal(t, []byte("node3"), v.registerValue("node1"))
+
+	// local write wins, even if remote timestamp is in the future
+	v.updatePart("node2", &SyncNodeValue{Version: 2, Register: &SyncRegister{Value: []byte("future"), Timestamp: time.Now().Add(time.Hour).UnixNano()}})
+	v.setRegister([]byte("local"))
+	assert.Equal(t, []byte("local"), v.registerValue("node1"))
+}
+
+func TestAPI_SetBytes(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	require.NoError(t, r.SetBytes("A", []byte("value")))
+
+	value, err := r.GetBytes("A")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	_, err = r.Get("A")
+	assert.Equal(t, ErrVariableKind, err)
+
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind: Kind_Register,
+				NodesValues: map[string]*SyncNodeValue{
+					"node2": {Version: 1, Register: &SyncRegister{Value: []byte("remote"), Timestamp: time.Now().Add(time.Second).UnixNano()}},
+				},
+			},
+		},
+	})
+
+	value, err = r.GetBytes("A")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("remote"), value)
+
+	r.Upsert("B", 1)
+	assert.Equal(t, ErrVariableKind, r.SetBytes("B", nil))
+	_, err = r.GetBytes("B")
+	assert.Equal(t, ErrVariableKind, err)
+
+	notExpired, _ := r.All()
+	assert.Equal(t, map[string]int64{"B": 1}, notExpired)
+}
@@ -0,0 +1,240 @@
+package rplx
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// ID layout: milliseconds since sequenceEpoch, slot of node, sequence number in millisecond
+	sequenceSlotBits = 10
+	sequenceSeqBits  = 12
+	sequenceSlots    = 1 << sequenceSlotBits
+	sequenceSeqMax   = 1<<sequenceSeqBits - 1
+
+	// sequenceLease - slot claim duration, claim is renewed by node, when less than half of lease remains
+	sequenceLease = int64(time.Minute * 10)
+
+	defaultSequenceSettle = defaultRemoteNodeSyncInterval * 3
+)
+
+var (
+	// sequenceEpoch in milliseconds, 2020-01-01 UTC
+	sequenceEpoch = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano() / int64(time.Millisecond)
+)
+
+// sequenceClaim describe slot claim of node
+type sequenceClaim struct {
+	nodeID  string
+	claimed int64
+}
+
+// wins returns true, if claim c wins concurrent claim o of the same slot
+// the earliest claim wins, claims with the same time are resolved by node ID
+func (c sequenceClaim) wins(o sequenceClaim) bool {
+	if c.claimed == o.claimed {
+		return c.nodeID < o.nodeID
+	}
+
+	return c.claimed < o.claimed
+}
+
+// sequencePart is node contribution to variable of Sequence kind
+// contains slot, reserved by the node, IDs of node contain the slot, so IDs of different nodes never match
+// the last millisecond of IDs is replicated, so node, which claims the slot later, starts after it.
+// node does not apply replicated data with own node ID, so after restart with the same node ID
+// and clock behind the last issued ID, node may issue IDs, issued before restart
+type sequencePart struct {
+	mx sync.RWMutex
+
+	slot    int32
+	claimed int64
+	expire  int64
+	ver     int64
+
+	// last issued ID parts, only lastMs is replicated
+	lastMs int64
+	seq    int64
+}
+
+func newSequencePart() *sequencePart {
+	return &sequencePart{}
+}
+
+// newSequenceVariable creates variable of Sequence kind
+func newSequenceVariable(name string) *variable {
+	v := newVariable(name)
+	v.kind = Kind_Sequence
+	v.newPart = func() part {
+		return newSequencePart()
+	}
+	v.selfPart = v.newPart()
+	v.remoteParts = make(map[string]part)
+
+	return v
+}
+
+func (p *sequencePart) version() int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.ver
+}
+
+func (p *sequencePart) touch() {
+	p.mx.Lock()
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+// reset releases slot, last issued ID is kept, so new IDs are greater than issued before reset
+func (p *sequencePart) reset() {
+	p.mx.Lock()
+	p.slot = 0
+	p.claimed = 0
+	p.expire = 0
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *sequencePart) syncValue() *SyncNodeValue {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return &SyncNodeValue{
+		Version: p.ver,
+		Sequence: &SyncSequence{
+			Slot:    p.slot,
+			Claimed: p.claimed,
+			Expire:  p.expire,
+			LastMs:  p.lastMs,
+		},
+	}
+}
+
+func (p *sequencePart) merge(n *SyncNodeValue) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.ver >= n.Version {
+		return false
+	}
+
+	p.slot, p.claimed, p.expire, p.lastMs = 0, 0, 0, 0
+	if n.Sequence != nil {
+		p.slot = n.Sequence.Slot
+		p.claimed = n.Sequence.Claimed
+		p.expire = n.Sequence.Expire
+		p.lastMs = n.Sequence.LastMs
+	}
+	p.ver = n.Version
+
+	return true
+}
+
+// sequenceClaims returns not expired slot claims of remote nodes, for each slot only the winning claim,
+// and the last millisecond of IDs for each slot, used by remote nodes, including expired claims
+func (v *variable) sequenceClaims(now int64) (map[int32]sequenceClaim, map[int32]int64) {
+	result := make(map[int32]sequenceClaim)
+	lastMs := make(map[int32]int64)
+
+	v.remoteItemsMx.RLock()
+	for nodeID, rp := range v.remoteParts {
+		p := rp.(*sequencePart)
+		p.mx.RLock()
+		if p.lastMs > lastMs[p.slot] {
+			lastMs[p.slot] = p.lastMs
+		}
+		if p.claimed > 0 && p.expire > now && p.slot >= 0 && p.slot < sequenceSlots {
+			c := sequenceClaim{nodeID: nodeID, claimed: p.claimed}
+			if o, ok := result[p.slot]; !ok || c.wins(o) {
+				result[p.slot] = c
+			}
+		}
+		p.mx.RUnlock()
+	}
+	v.remoteItemsMx.RUnlock()
+
+	return result, lastMs
+}
+
+// sequenceNext returns next ID of the node at time now
+// node claims free slot, if it has no slot or lost slot in conflict, and renews own claim
+// ID is issued only after claim is older than settle, otherwise returns time to wait in nanoseconds
+// changed is true, if self part was changed and must be replicated
+func (v *variable) sequenceNext(localNodeID string, now, settle int64) (id int64, wait int64, changed bool, err error) {
+	others, othersLastMs := v.sequenceClaims(now)
+
+	p := v.selfPart.(*sequencePart)
+
+	p.mx.Lock()
+	defer func() {
+		p.mx.Unlock()
+		if changed {
+			atomic.AddInt64(&v.changes, 1)
+		}
+	}()
+
+	lost := p.claimed == 0 || p.expire <= now
+	if o, ok := others[p.slot]; !lost && ok && o.wins(sequenceClaim{nodeID: localNodeID, claimed: p.claimed}) {
+		lost = true
+	}
+
+	switch {
+	case lost:
+		slot := int32(-1)
+		for s := int32(0); s < sequenceSlots; s++ {
+			if _, ok := others[s]; !ok {
+				slot = s
+				break
+			}
+		}
+		if slot < 0 {
+			return 0, 0, false, ErrNoFreeSlots
+		}
+
+		p.slot = slot
+		p.claimed = now
+		p.expire = now + sequenceLease
+		// IDs with new slot start from the next millisecond, so they are greater than issued before by the node
+		// and by other nodes with the slot
+		if othersLastMs[slot] > p.lastMs {
+			p.lastMs = othersLastMs[slot]
+		}
+		p.lastMs++
+		p.seq = -1
+		p.ver = nextVersion(p.ver)
+		changed = true
+	case p.expire-now < sequenceLease/2:
+		p.expire = now + sequenceLease
+		p.ver = nextVersion(p.ver)
+		changed = true
+	}
+
+	if age := now - p.claimed; age < settle {
+		return 0, settle - age, changed, nil
+	}
+
+	ms := now/int64(time.Millisecond) - sequenceEpoch
+	if ms > p.lastMs {
+		p.lastMs = ms
+		p.seq = 0
+	} else {
+		// clock is behind the last issued ID or sequence of the millisecond continues
+		p.seq++
+		if p.seq > sequenceSeqMax {
+			p.lastMs++
+			p.seq = 0
+		}
+	}
+	// the new millisecond is replicated, sequence number in millisecond is not
+	if p.seq == 0 {
+		p.ver = nextVersion(p.ver)
+		changed = true
+	}
+
+	id = p.lastMs<<(sequenceSlotBits+sequenceSeqBits) | int64(p.slot)<<sequenceSeqBits | p.seq
+
+	return id, 0, changed, nil
+}
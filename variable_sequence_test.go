@@ -0,0 +1,125 @@
+package rplx
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// sequenceSlot returns slot of ID
+func sequenceSlot(id int64) int32 {
+	return int32(id>>sequenceSeqBits) & (sequenceSlots - 1)
+}
+
+func TestVariable_SequenceNext(t *testing.T) {
+	v := newSequenceVariable("A")
+	now := time.Now().UTC().UnixNano()
+	settle := int64(time.Second)
+
+	// claim is not settled
+	_, wait, changed, err := v.sequenceNext("node1", now, settle)
+	require.NoError(t, err)
+	assert.Equal(t, settle, wait)
+	assert.True(t, changed)
+
+	now += 2 * settle
+	id1, wait, changed, err := v.sequenceNext("node1", now, settle)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), wait)
+	assert.True(t, changed)
+	assert.Equal(t, int32(0), sequenceSlot(id1))
+
+	// sequence number in the same millisecond is not replicated
+	id, _, changed, err := v.sequenceNext("node1", now, settle)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, id1+1, id)
+	id1 = id
+
+	// clock goes back, IDs are still increasing
+	id2, _, _, err := v.sequenceNext("node1", now-int64(time.Millisecond), settle)
+	require.NoError(t, err)
+	assert.True(t, id2 > id1)
+
+	// later claim of node2 does not win
+	v.updatePart("node2", &SyncNodeValue{Version: 1, Sequence: &SyncSequence{Slot: 0, Claimed: now, Expire: now + sequenceLease}})
+	id3, _, _, err := v.sequenceNext("node1", now, settle)
+	require.NoError(t, err)
+	assert.True(t, id3 > id2)
+	assert.Equal(t, int32(0), sequenceSlot(id3))
+
+	// earlier claim of node0 wins, node claims the next free slot and waits settle again
+	v.updatePart("node0", &SyncNodeValue{Version: 1, Sequence: &SyncSequence{Slot: 0, Claimed: now - 2*settle, Expire: now + sequenceLease}})
+	_, wait, changed, err = v.sequenceNext("node1", now, settle)
+	require.NoError(t, err)
+	assert.Equal(t, settle, wait)
+	assert.True(t, changed)
+
+	now += settle
+	id4, _, _, err := v.sequenceNext("node1", now, settle)
+	require.NoError(t, err)
+	assert.True(t, id4 > id3)
+	assert.Equal(t, int32(1), sequenceSlot(id4))
+}
+
+func TestVariable_SequenceSlotLastMs(t *testing.T) {
+	v := newSequenceVariable("A")
+	now := time.Now().UTC().UnixNano()
+	ms := now/int64(time.Millisecond) - sequenceEpoch
+
+	// node2 used slot 0 with clock ahead, claim is expired
+	v.updatePart("node2", &SyncNodeValue{Version: 1, Sequence: &SyncSequence{Slot: 0, Claimed: now - 2*sequenceLease, Expire: now - sequenceLease, LastMs: ms + 1000}})
+
+	id, _, _, err := v.sequenceNext("node1", now, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), sequenceSlot(id))
+	assert.Equal(t, ms+1001, id>>(sequenceSlotBits+sequenceSeqBits))
+
+	// the last millisecond is replicated
+	n := v.selfPart.(*sequencePart).syncValue()
+	assert.Equal(t, ms+1001, n.Sequence.LastMs)
+}
+
+func TestVariable_SequenceNoFreeSlots(t *testing.T) {
+	v := newSequenceVariable("A")
+	now := time.Now().UTC().UnixNano()
+
+	for s := int32(0); s < sequenceSlots; s++ {
+		v.updatePart("node"+strconv.Itoa(int(s)+2), &SyncNodeValue{
+			Version:  1,
+			Sequence: &SyncSequence{Slot: s, Claimed: now - 1, Expire: now + sequenceLease},
+		})
+	}
+
+	_, _, _, err := v.sequenceNext("node1", now, 0)
+	assert.Equal(t, ErrNoFreeSlots, err)
+
+	// expired claims are free
+	_, _, _, err = v.sequenceNext("node1", now+sequenceLease, 0)
+	assert.NoError(t, err)
+}
+
+func TestAPI_NextID(t *testing.T) {
+	r := New(WithNodeID("node1"), WithSequenceSettle(0))
+
+	last := int64(0)
+	for i := 0; i < 10000; i++ {
+		id, err := r.NextID(context.Background(), "A")
+		require.NoError(t, err)
+		require.True(t, id > last)
+		last = id
+	}
+
+	r2 := New(WithNodeID("node2"), WithSequenceSettle(time.Hour))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := r2.NextID(ctx, "A")
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	r.Upsert("B", 1)
+	_, err = r.NextID(context.Background(), "B")
+	assert.Equal(t, ErrVariableKind, err)
+}
@@ -0,0 +1,313 @@
+package rplx
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// setTag is unique tag of member add, tag is identified by node ID and seq
+type setTag struct {
+	seq    uint64
+	expire int64
+}
+
+// setTagID identifies tag of any node
+type setTagID struct {
+	nodeID string
+	seq    uint64
+}
+
+// setPart is node contribution to variable of Set kind (observed-remove set)
+// contains tags of members, added on the node, and tags of other nodes, removed on the node
+// member is in set, if it has at least one not removed and not expired tag
+type setPart struct {
+	mx sync.RWMutex
+
+	seq uint64
+	// map key - set member, node keeps only the latest tag for each member
+	adds map[string]setTag
+	// removed tags of other nodes, map value - tag expiration time
+	removes map[setTagID]int64
+	ver     int64
+}
+
+func newSetPart() *setPart {
+	return &setPart{
+		adds:    make(map[string]setTag),
+		removes: make(map[setTagID]int64),
+	}
+}
+
+// newSetVariable creates variable of Set kind
+func newSetVariable(name string) *variable {
+	v := newVariable(name)
+	v.kind = Kind_Set
+	v.newPart = func() part {
+		return newSetPart()
+	}
+	v.selfPart = v.newPart()
+	v.remoteParts = make(map[string]part)
+
+	return v
+}
+
+func (p *setPart) version() int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.ver
+}
+
+func (p *setPart) touch() {
+	p.mx.Lock()
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+// reset clears part data, seq is not reset, so new tags never match old tombstones
+func (p *setPart) reset() {
+	p.mx.Lock()
+	p.adds = make(map[string]setTag)
+	p.removes = make(map[setTagID]int64)
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *setPart) syncValue() *SyncNodeValue {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	s := &SyncSet{
+		Adds:    make(map[string]*SyncSetTag, len(p.adds)),
+		Removes: make([]*SyncSetRemove, 0, len(p.removes)),
+		Seq:     p.seq,
+	}
+
+	for member, tag := range p.adds {
+		s.Adds[member] = &SyncSetTag{Seq: tag.seq, Expire: tag.expire}
+	}
+
+	for id, expire := range p.removes {
+		s.Removes = append(s.Removes, &SyncSetRemove{NodeID: id.nodeID, Seq: id.seq, Expire: expire})
+	}
+
+	return &SyncNodeValue{
+		Version: p.ver,
+		Set:     s,
+	}
+}
+
+func (p *setPart) merge(n *SyncNodeValue) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.ver >= n.Version {
+		return false
+	}
+
+	p.seq = 0
+	p.adds = make(map[string]setTag)
+	p.removes = make(map[setTagID]int64)
+
+	if n.Set != nil {
+		p.seq = n.Set.Seq
+		for member, tag := range n.Set.Adds {
+			if tag != nil {
+				p.adds[member] = setTag{seq: tag.Seq, expire: tag.Expire}
+			}
+		}
+		for _, r := range n.Set.Removes {
+			if r != nil {
+				p.removes[setTagID{nodeID: r.NodeID, seq: r.Seq}] = r.Expire
+			}
+		}
+	}
+
+	p.ver = n.Version
+
+	return true
+}
+
+// setTombstones returns removed tags of all nodes
+func (v *variable) setTombstones() map[setTagID]struct{} {
+	result := make(map[setTagID]struct{})
+
+	v.eachPart("", func(_ string, p part) {
+		sp := p.(*setPart)
+		sp.mx.RLock()
+		for id := range sp.removes {
+			result[id] = struct{}{}
+		}
+		sp.mx.RUnlock()
+	})
+
+	return result
+}
+
+// alive returns true, if tag is not expired and not removed by any node
+func (t setTag) alive(id setTagID, removed map[setTagID]struct{}, now int64) bool {
+	if t.expire > 0 && t.expire < now {
+		return false
+	}
+
+	_, ok := removed[id]
+
+	return !ok
+}
+
+// setMembers returns sorted set members
+func (v *variable) setMembers(localNodeID string) []string {
+	removed := v.setTombstones()
+	now := time.Now().UTC().UnixNano()
+	members := make(map[string]struct{})
+
+	v.eachPart(localNodeID, func(nodeID string, p part) {
+		sp := p.(*setPart)
+		sp.mx.RLock()
+		for member, tag := range sp.adds {
+			if tag.alive(setTagID{nodeID: nodeID, seq: tag.seq}, removed, now) {
+				members[member] = struct{}{}
+			}
+		}
+		sp.mx.RUnlock()
+	})
+
+	result := make([]string, 0, len(members))
+	for member := range members {
+		result = append(result, member)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+// setIsMember returns true, if member is in set
+func (v *variable) setIsMember(localNodeID string, member string) bool {
+	removed := v.setTombstones()
+	now := time.Now().UTC().UnixNano()
+	result := false
+
+	v.eachPart(localNodeID, func(nodeID string, p part) {
+		sp := p.(*setPart)
+		sp.mx.RLock()
+		if tag, ok := sp.adds[member]; ok && tag.alive(setTagID{nodeID: nodeID, seq: tag.seq}, removed, now) {
+			result = true
+		}
+		sp.mx.RUnlock()
+	})
+
+	return result
+}
+
+// setAdd adds members to self part with new tags, expire is tag expiration time in unix nano or 0
+func (v *variable) setAdd(expire int64, members ...string) {
+	p := v.selfPart.(*setPart)
+
+	p.mx.Lock()
+	for _, member := range members {
+		p.seq++
+		p.adds[member] = setTag{seq: p.seq, expire: expire}
+	}
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+
+	atomic.AddInt64(&v.changes, 1)
+}
+
+// setRemove removes all observed tags of members
+// tags of self part are deleted, tags of other nodes are stored as tombstones
+func (v *variable) setRemove(localNodeID string, members ...string) {
+	observed := make(map[setTagID]int64)
+
+	for _, member := range members {
+		v.eachPart(localNodeID, func(nodeID string, p part) {
+			sp := p.(*setPart)
+			sp.mx.RLock()
+			if tag, ok := sp.adds[member]; ok {
+				observed[setTagID{nodeID: nodeID, seq: tag.seq}] = tag.expire
+			}
+			sp.mx.RUnlock()
+		})
+	}
+
+	p := v.selfPart.(*setPart)
+
+	p.mx.Lock()
+	for _, member := range members {
+		delete(p.adds, member)
+	}
+	for id, expire := range observed {
+		if id.nodeID != localNodeID {
+			p.removes[id] = expire
+		}
+	}
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+
+	atomic.AddInt64(&v.changes, 1)
+}
+
+// compactSet removes expired tags, self tags removed by other nodes and tombstones,
+// which are not needed anymore, from self part
+// tombstone is not needed, if tag is expired or tag owner node removed the tag from own part
+// returns true, if self part was changed
+func (v *variable) compactSet(localNodeID string, now int64) bool {
+	removedSelf := make(map[uint64]struct{})
+	remoteSeq := make(map[string]uint64)
+	remoteTags := make(map[setTagID]struct{})
+
+	v.remoteItemsMx.RLock()
+	for nodeID, rp := range v.remoteParts {
+		sp := rp.(*setPart)
+		sp.mx.RLock()
+		for id := range sp.removes {
+			if id.nodeID == localNodeID {
+				removedSelf[id.seq] = struct{}{}
+			}
+		}
+		for _, tag := range sp.adds {
+			remoteTags[setTagID{nodeID: nodeID, seq: tag.seq}] = struct{}{}
+		}
+		remoteSeq[nodeID] = sp.seq
+		sp.mx.RUnlock()
+	}
+	v.remoteItemsMx.RUnlock()
+
+	p := v.selfPart.(*setPart)
+
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	changed := false
+
+	for member, tag := range p.adds {
+		_, removed := removedSelf[tag.seq]
+		if removed || (tag.expire > 0 && tag.expire < now) {
+			delete(p.adds, member)
+			changed = true
+		}
+	}
+
+	for id, expire := range p.removes {
+		if expire > 0 && expire < now {
+			delete(p.removes, id)
+			changed = true
+			continue
+		}
+
+		// owner node already issued the tag and does not contain it anymore
+		seq, ok := remoteSeq[id.nodeID]
+		if _, exists := remoteTags[id]; ok && seq >= id.seq && !exists {
+			delete(p.removes, id)
+			changed = true
+		}
+	}
+
+	if changed {
+		p.ver = nextVersion(p.ver)
+	}
+
+	return changed
+}
@@ -0,0 +1,183 @@
+package rplx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestSetPart_Merge(t *testing.T) {
+	p := newSetPart()
+	p.ver = 10
+
+	n := &SyncNodeValue{
+		Version: 20,
+		Set: &SyncSet{
+			Adds:    map[string]*SyncSetTag{"a": {Seq: 2}},
+			Removes: []*SyncSetRemove{{NodeID: "node3", Seq: 1}},
+			Seq:     2,
+		},
+	}
+
+	assert.False(t, p.merge(&SyncNodeValue{Version: 5}))
+	assert.True(t, p.merge(n))
+
+	assert.Equal(t, uint64(2), p.seq)
+	assert.Equal(t, map[string]setTag{"a": {seq: 2}}, p.adds)
+	assert.Equal(t, map[setTagID]int64{{nodeID: "node3", seq: 1}: 0}, p.removes)
+	assert.Equal(t, n, p.syncValue())
+}
+
+func TestAPI_Set(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	require.NoError(t, r.SAdd("A", 0, "b", "a"))
+	require.NoError(t, r.SAdd("A", time.Millisecond*50, "c"))
+
+	members, err := r.SMembers("A")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, members)
+
+	require.NoError(t, r.SRem("A", "b"))
+
+	ok, err := r.SIsMember("A", "b")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = r.SIsMember("A", "a")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// member with TTL expires
+	time.Sleep(time.Millisecond * 100)
+
+	members, err = r.SMembers("A")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, members)
+
+	_, err = r.SMembers("B")
+	assert.Equal(t, ErrVariableNotExists, err)
+
+	r.Upsert("C", 1)
+	assert.Equal(t, ErrVariableKind, r.SAdd("C", 0, "a"))
+	_, err = r.Get("A")
+	assert.Equal(t, ErrVariableKind, err)
+}
+
+func TestRplx_SyncSet(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	require.NoError(t, r.SAdd("A", 0, "a"))
+
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind: Kind_Set,
+				NodesValues: map[string]*SyncNodeValue{
+					"node2": {Version: 1, Set: &SyncSet{Adds: map[string]*SyncSetTag{"a": {Seq: 1}, "b": {Seq: 2}}, Seq: 2}},
+				},
+			},
+		},
+	})
+
+	members, err := r.SMembers("A")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, members)
+
+	// remove observed tags of both nodes
+	require.NoError(t, r.SRem("A", "a"))
+
+	members, err = r.SMembers("A")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, members)
+
+	// concurrent add on node2 with new tag wins
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind: Kind_Set,
+				NodesValues: map[string]*SyncNodeValue{
+					"node2": {Version: 2, Set: &SyncSet{Adds: map[string]*SyncSetTag{"a": {Seq: 3}, "b": {Seq: 2}}, Seq: 3}},
+				},
+			},
+		},
+	})
+
+	ok, err := r.SIsMember("A", "a")
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestVariable_CompactSet(t *testing.T) {
+	v := newSetVariable("A")
+	v.setAdd(0, "a", "b")
+
+	now := time.Now().UTC().UnixNano()
+
+	v.updatePart("node2", &SyncNodeValue{
+		Version: 1,
+		Set: &SyncSet{
+			Adds:    map[string]*SyncSetTag{"c": {Seq: 1}, "d": {Seq: 2}},
+			Removes: []*SyncSetRemove{{NodeID: "node1", Seq: 1}},
+			Seq:     2,
+		},
+	})
+
+	v.setRemove("node1", "c", "d")
+	v.setAdd(now-1, "e")
+
+	self := v.selfPart.(*setPart)
+	assert.Len(t, self.removes, 2)
+
+	// self tag of "a" is removed by node2, tag of "e" is expired
+	assert.True(t, v.compact("node1", now))
+	assert.Equal(t, map[string]setTag{"b": {seq: 2}}, self.adds)
+	assert.Len(t, self.removes, 2)
+
+	// node2 removed tag of "c" from own part, tombstone is not needed anymore
+	v.updatePart("node2", &SyncNodeValue{
+		Version: 2,
+		Set: &SyncSet{
+			Adds: map[string]*SyncSetTag{"d": {Seq: 2}},
+			Seq:  2,
+		},
+	})
+
+	assert.True(t, v.compact("node1", now))
+	assert.Equal(t, map[setTagID]int64{{nodeID: "node2", seq: 2}: 0}, self.removes)
+	assert.False(t, v.compact("node1", now))
+
+	assert.Equal(t, []string{"b"}, v.setMembers("node1"))
+}
+
+func TestRplx_Compact(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	require.NoError(t, r.SAdd("A", time.Millisecond, "a"))
+	require.Len(t, r.compactable, 1)
+
+	time.Sleep(time.Millisecond * 5)
+
+	// expiry GC does not compact variables
+	r.gc()
+
+	v, err := r.load("A")
+	require.NoError(t, err)
+	assert.Len(t, v.selfPart.(*setPart).adds, 1)
+
+	r.compact(time.Now().UTC().UnixNano())
+	assert.Len(t, v.selfPart.(*setPart).adds, 0)
+
+	r.variablesMx.Lock()
+	delete(r.variables, "A")
+	r.variablesMx.Unlock()
+
+	r.compact(time.Now().UTC().UnixNano())
+
+	r.compactableMx.Lock()
+	assert.Len(t, r.compactable, 0)
+	r.compactableMx.Unlock()
+}
@@ -0,0 +1,210 @@
+package rplx
+
+import (
+	"sync"
+)
+
+// ScoredMember describe member of sorted set with score
+type ScoredMember struct {
+	Member string
+	Score  int64
+}
+
+// sortedIndex is members of sorted set, ordered by score and member
+// index is updated only for changed members, full rebuild is needed only after reset of parts
+type sortedIndex struct {
+	mx   sync.Mutex
+	list *skipList
+	// map value - member score in list
+	scores map[string]int64
+
+	// members, changed after the last index update
+	changedMx sync.Mutex
+	changed   map[string]struct{}
+	rebuild   bool
+}
+
+func newSortedIndex() *sortedIndex {
+	return &sortedIndex{
+		list:    newSkipList(),
+		scores:  make(map[string]int64),
+		changed: make(map[string]struct{}),
+	}
+}
+
+// newSortedSetVariable creates variable of SortedSet kind
+// member score is sum of nodes values, parts are the same as for Hash kind
+func newSortedSetVariable(name string) *variable {
+	v := newHashVariable(name)
+	v.kind = Kind_SortedSet
+	v.sorted = newSortedIndex()
+
+	return v
+}
+
+// markChanged marks members, which scores must be updated in index
+func (idx *sortedIndex) markChanged(members ...string) {
+	idx.changedMx.Lock()
+	for _, member := range members {
+		idx.changed[member] = struct{}{}
+	}
+	idx.changedMx.Unlock()
+}
+
+// markRebuild marks index for full rebuild, used after reset of parts
+func (idx *sortedIndex) markRebuild() {
+	idx.changedMx.Lock()
+	idx.rebuild = true
+	idx.changed = make(map[string]struct{})
+	idx.changedMx.Unlock()
+}
+
+// mergeSorted applies replicated data to part of SortedSet variable and marks changed members
+func (idx *sortedIndex) mergeSorted(p *hashPart, n *SyncNodeValue) bool {
+	resetVer := p.resetVersion()
+
+	if !p.merge(n) {
+		return false
+	}
+
+	if p.resetVersion() != resetVer {
+		idx.markRebuild()
+		return true
+	}
+
+	if n.Hash != nil {
+		for member := range n.Hash.Fields {
+			idx.markChanged(member)
+		}
+	}
+
+	return true
+}
+
+// withSortedIndex updates index for changed members and calls fn with index under lock
+func (v *variable) withSortedIndex(fn func(idx *sortedIndex)) {
+	idx := v.sorted
+
+	idx.mx.Lock()
+	defer idx.mx.Unlock()
+
+	idx.changedMx.Lock()
+	changed, rebuild := idx.changed, idx.rebuild
+	idx.changed = make(map[string]struct{})
+	idx.rebuild = false
+	idx.changedMx.Unlock()
+
+	if rebuild {
+		idx.list = newSkipList()
+		idx.scores = make(map[string]int64)
+
+		for member, score := range v.hashGetAll() {
+			idx.list.insert(score, member)
+			idx.scores[member] = score
+		}
+	}
+
+	for member := range changed {
+		score, ok := v.hashGet(member)
+
+		old, indexed := idx.scores[member]
+		if indexed && ok && old == score {
+			continue
+		}
+
+		if indexed {
+			idx.list.remove(old, member)
+			delete(idx.scores, member)
+		}
+
+		if ok {
+			idx.list.insert(score, member)
+			idx.scores[member] = score
+		}
+	}
+
+	if fn != nil {
+		fn(idx)
+	}
+}
+
+// updateSortedIndex applies changed members to index of SortedSet variable
+func (v *variable) updateSortedIndex() {
+	v.withSortedIndex(nil)
+}
+
+// sortedRank returns member rank in ascending or descending order, false if member not exists
+func (v *variable) sortedRank(member string, reverse bool) (int, bool) {
+	rank := -1
+
+	v.withSortedIndex(func(idx *sortedIndex) {
+		score, ok := idx.scores[member]
+		if !ok {
+			return
+		}
+
+		rank = idx.list.rank(score, member)
+		if reverse && rank >= 0 {
+			rank = idx.list.length - 1 - rank
+		}
+	})
+
+	return rank, rank >= 0
+}
+
+// sortedRangeByRank returns members with ranks from start to stop inclusive
+// negative rank is offset from the end, -1 is the last member
+func (v *variable) sortedRangeByRank(start, stop int, reverse bool) []ScoredMember {
+	result := make([]ScoredMember, 0)
+
+	v.withSortedIndex(func(idx *sortedIndex) {
+		count := idx.list.length
+
+		if start < 0 {
+			start += count
+		}
+		if stop < 0 {
+			stop += count
+		}
+		if start < 0 {
+			start = 0
+		}
+		if stop >= count {
+			stop = count - 1
+		}
+		if start > stop {
+			return
+		}
+
+		// descending ranks are ascending ranks from the end
+		from := start
+		if reverse {
+			from = count - 1 - stop
+		}
+
+		for n := idx.list.byRank(from); n != nil && len(result) < stop-start+1; n = n.next[0] {
+			result = append(result, ScoredMember{Member: n.member, Score: n.score})
+		}
+	})
+
+	if reverse {
+		for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+			result[i], result[j] = result[j], result[i]
+		}
+	}
+
+	return result
+}
+
+// sortedRangeByScore returns members with score in range [min, max] in ascending order
+func (v *variable) sortedRangeByScore(min, max int64) []ScoredMember {
+	result := make([]ScoredMember, 0)
+
+	v.withSortedIndex(func(idx *sortedIndex) {
+		for n := idx.list.firstFrom(min); n != nil && n.score <= max; n = n.next[0] {
+			result = append(result, ScoredMember{Member: n.member, Score: n.score})
+		}
+	})
+
+	return result
+}
@@ -0,0 +1,120 @@
+package rplx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSortedSet_Index(t *testing.T) {
+	v := newSortedSetVariable("A")
+	v.hashIncr("a", 3)
+	v.hashIncr("b", 1)
+	v.hashIncr("c", 3)
+	v.hashIncr("d", 2)
+
+	assert.Equal(t, []ScoredMember{{"b", 1}, {"d", 2}, {"a", 3}, {"c", 3}}, v.sortedRangeByRank(0, -1, false))
+	assert.Equal(t, []ScoredMember{{"d", 2}, {"a", 3}}, v.sortedRangeByRank(1, 2, false))
+	assert.Equal(t, []ScoredMember{{"c", 3}, {"a", 3}}, v.sortedRangeByRank(0, 1, true))
+	assert.Equal(t, []ScoredMember{{"a", 3}, {"c", 3}}, v.sortedRangeByRank(-2, 10, false))
+	assert.Empty(t, v.sortedRangeByRank(3, 1, false))
+	assert.Equal(t, []ScoredMember{{"d", 2}, {"a", 3}, {"c", 3}}, v.sortedRangeByScore(2, 5))
+	assert.Empty(t, v.sortedRangeByScore(4, 5))
+
+	// index is updated for changed member
+	v.hashIncr("b", 10)
+	rank, ok := v.sortedRank("b", false)
+	assert.True(t, ok)
+	assert.Equal(t, 3, rank)
+
+	rank, ok = v.sortedRank("b", true)
+	assert.True(t, ok)
+	assert.Equal(t, 0, rank)
+
+	_, ok = v.sortedRank("e", false)
+	assert.False(t, ok)
+}
+
+func TestSortedSet_IndexMerge(t *testing.T) {
+	v := newSortedSetVariable("A")
+	v.hashIncr("a", 3)
+
+	remote := newHashPart()
+	remote.incr("a", 1)
+	remote.incr("b", 10)
+	full := remote.syncValue()
+	v.updatePart("node2", full)
+
+	assert.Equal(t, []ScoredMember{{"a", 4}, {"b", 10}}, v.sortedRangeByRank(0, -1, false))
+
+	// only changed members are updated
+	remote.incr("a", 10)
+	v.updatePart("node2", remote.syncDelta(full.Version))
+	assert.Len(t, v.sorted.changed, 1)
+	assert.Equal(t, []ScoredMember{{"b", 10}, {"a", 14}}, v.sortedRangeByRank(0, -1, false))
+
+	// remote reset rebuilds index
+	remote.reset()
+	remote.incr("c", 1)
+	v.updatePart("node2", remote.syncValue())
+	assert.Equal(t, []ScoredMember{{"c", 1}, {"a", 3}}, v.sortedRangeByRank(0, -1, false))
+
+	// reset of parts rebuilds index
+	v.resetParts()
+	assert.Empty(t, v.sortedRangeByRank(0, -1, false))
+}
+
+func TestAPI_SortedSet(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	score, err := r.ZIncrBy("A", "alice", 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), score)
+
+	_, err = r.ZIncrBy("A", "bob", 20)
+	require.NoError(t, err)
+
+	// node2 scored alice and carol
+	remote := newHashPart()
+	remote.incr("alice", 15)
+	remote.incr("carol", 5)
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind:        Kind_SortedSet,
+				NodesValues: map[string]*SyncNodeValue{"node2": remote.syncValue()},
+			},
+		},
+	})
+
+	score, err = r.ZScore("A", "alice")
+	require.NoError(t, err)
+	assert.Equal(t, int64(25), score)
+
+	top, err := r.ZRevRange("A", 0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []ScoredMember{{"alice", 25}, {"bob", 20}}, top)
+
+	rank, err := r.ZRevRank("A", "carol")
+	require.NoError(t, err)
+	assert.Equal(t, 2, rank)
+
+	rank, err = r.ZRank("A", "carol")
+	require.NoError(t, err)
+	assert.Equal(t, 0, rank)
+
+	members, err := r.ZRangeByScore("A", 10, 20)
+	require.NoError(t, err)
+	assert.Equal(t, []ScoredMember{{"bob", 20}}, members)
+
+	members, err = r.ZRange("A", 0, -1)
+	require.NoError(t, err)
+	assert.Len(t, members, 3)
+
+	_, err = r.ZScore("A", "dave")
+	assert.Equal(t, ErrFieldNotExists, err)
+
+	_, err = r.HGet("A", "alice")
+	assert.Equal(t, ErrVariableKind, err)
+}
@@ -0,0 +1,172 @@
+package rplx
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// windowPart is node contribution to variable of Window kind
+// contains values of time buckets, buckets older than window are removed
+type windowPart struct {
+	mx sync.RWMutex
+
+	// size and bucketSize in nanoseconds
+	size       int64
+	bucketSize int64
+
+	// map key - bucket index, time in nanoseconds / bucketSize
+	buckets map[int64]int64
+	ver     int64
+}
+
+func newWindowPart(size, bucketSize int64) *windowPart {
+	return &windowPart{
+		size:       size,
+		bucketSize: bucketSize,
+		buckets:    make(map[int64]int64),
+	}
+}
+
+// newWindowVariable creates variable of Window kind
+func newWindowVariable(name string, size, bucketSize int64) *variable {
+	v := newVariable(name)
+	v.kind = Kind_Window
+	v.newPart = func() part {
+		return newWindowPart(size, bucketSize)
+	}
+	v.selfPart = v.newPart()
+	v.remoteParts = make(map[string]part)
+
+	return v
+}
+
+// first returns index of the first bucket in window for time now
+func (p *windowPart) first(now int64) int64 {
+	return now/p.bucketSize - p.size/p.bucketSize + 1
+}
+
+// prune removes buckets older than window, must be called under lock
+func (p *windowPart) prune(now int64) {
+	first := p.first(now)
+
+	for idx := range p.buckets {
+		if idx < first {
+			delete(p.buckets, idx)
+		}
+	}
+}
+
+func (p *windowPart) update(delta int64) {
+	now := time.Now().UTC().UnixNano()
+
+	p.mx.Lock()
+	p.prune(now)
+	p.buckets[now/p.bucketSize] += delta
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+// value returns sum of buckets in window for time now
+func (p *windowPart) value(now int64) int64 {
+	first := p.first(now)
+
+	var result int64
+
+	p.mx.RLock()
+	for idx, value := range p.buckets {
+		if idx >= first {
+			result += value
+		}
+	}
+	p.mx.RUnlock()
+
+	return result
+}
+
+func (p *windowPart) version() int64 {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.ver
+}
+
+func (p *windowPart) touch() {
+	p.mx.Lock()
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *windowPart) reset() {
+	p.mx.Lock()
+	p.buckets = make(map[int64]int64)
+	p.ver = nextVersion(p.ver)
+	p.mx.Unlock()
+}
+
+func (p *windowPart) syncValue() *SyncNodeValue {
+	first := p.first(time.Now().UTC().UnixNano())
+
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	n := &SyncNodeValue{
+		Version: p.ver,
+		Buckets: make(map[int64]int64, len(p.buckets)),
+	}
+
+	for idx, value := range p.buckets {
+		if idx >= first {
+			n.Buckets[idx] = value
+		}
+	}
+
+	return n
+}
+
+func (p *windowPart) merge(n *SyncNodeValue) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.ver >= n.Version {
+		return false
+	}
+
+	p.buckets = make(map[int64]int64, len(n.Buckets))
+	for idx, value := range n.Buckets {
+		p.buckets[idx] = value
+	}
+	p.prune(time.Now().UTC().UnixNano())
+	p.ver = n.Version
+
+	return true
+}
+
+// windowSum returns sum of all nodes parts in window for time now
+func (v *variable) windowSum(now int64) int64 {
+	result := v.selfPart.(*windowPart).value(now)
+
+	v.remoteItemsMx.RLock()
+	for _, p := range v.remoteParts {
+		result += p.(*windowPart).value(now)
+	}
+	v.remoteItemsMx.RUnlock()
+
+	return result
+}
+
+// updateWindow adds delta to the current bucket of self part
+func (v *variable) updateWindow(delta int64) {
+	v.selfPart.(*windowPart).update(delta)
+	atomic.AddInt64(&v.changes, 1)
+}
+
+// windowOptions returns window options for replication
+func (v *variable) windowOptions() *SyncWindow {
+	p := v.selfPart.(*windowPart)
+
+	return &SyncWindow{
+		Size:       p.size,
+		BucketSize: p.bucketSize,
+	}
+}
@@ -0,0 +1,173 @@
+package rplx
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestWindowPart_ValueInWindow(t *testing.T) {
+	p := newWindowPart(30, 10)
+
+	p.buckets = map[int64]int64{
+		1: 100,
+		2: 200,
+		3: 300,
+		4: 400,
+	}
+
+	// window for time 45 contains buckets 2, 3, 4
+	assert.Equal(t, int64(900), p.value(45))
+	assert.Equal(t, int64(400), p.value(65))
+	assert.Equal(t, int64(0), p.value(70))
+}
+
+func TestWindowPart_Merge(t *testing.T) {
+	p := newWindowPart(int64(time.Minute), int64(time.Second))
+	p.ver = 10
+
+	idx := time.Now().UTC().UnixNano() / int64(time.Second)
+
+	assert.False(t, p.merge(&SyncNodeValue{Version: 5, Buckets: map[int64]int64{idx: 1}}))
+	assert.True(t, p.merge(&SyncNodeValue{Version: 20, Buckets: map[int64]int64{idx: 2, 1: 100}}))
+
+	assert.Equal(t, int64(20), p.version())
+	assert.Equal(t, map[int64]int64{idx: 2}, p.buckets)
+}
+
+func TestAPI_UpsertWindow(t *testing.T) {
+	r := New()
+
+	_, err := r.UpsertWindow("A", 1, time.Millisecond, time.Second)
+	assert.Equal(t, ErrInvalidWindow, err)
+
+	value, err := r.UpsertWindow("A", 10, time.Millisecond*100, time.Millisecond*10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), value)
+
+	assert.Equal(t, int64(15), r.Upsert("A", 5))
+
+	v, err := r.Get("A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(15), v)
+
+	time.Sleep(time.Millisecond * 150)
+
+	v, err = r.Get("A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), v)
+
+	r.Upsert("B", 1)
+	_, err = r.UpsertWindow("B", 1, time.Second, time.Second)
+	assert.Equal(t, ErrVariableKind, err)
+}
+
+func TestRplx_SyncWindow(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	_, err := r.UpsertWindow("A", 10, time.Minute, time.Second)
+	require.NoError(t, err)
+
+	idx := time.Now().UTC().UnixNano() / int64(time.Second)
+
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				Kind:   Kind_Window,
+				Window: &SyncWindow{Size: int64(time.Minute), BucketSize: int64(time.Second)},
+				NodesValues: map[string]*SyncNodeValue{
+					"node2": {Version: 1, Buckets: map[int64]int64{idx: 20, idx - 1: 30}},
+				},
+			},
+			"B": {
+				Kind:   Kind_Window,
+				Window: &SyncWindow{Size: int64(time.Minute), BucketSize: int64(time.Second)},
+				NodesValues: map[string]*SyncNodeValue{
+					"node2": {Version: 1, Buckets: map[int64]int64{idx: 5}},
+				},
+			},
+			"C": {
+				Kind: Kind_Window,
+				NodesValues: map[string]*SyncNodeValue{
+					"node2": {Version: 1, Buckets: map[int64]int64{idx: 5}},
+				},
+			},
+		},
+	})
+
+	v, err := r.Get("A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(60), v)
+
+	v, err = r.Get("B")
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), v)
+
+	// variable without window options is skipped
+	_, err = r.Get("C")
+	assert.Equal(t, ErrVariableNotExists, err)
+
+	// kind mismatch is skipped
+	r.Upsert("D", 1)
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"D": {
+				Kind:   Kind_Window,
+				Window: &SyncWindow{Size: int64(time.Minute), BucketSize: int64(time.Second)},
+				NodesValues: map[string]*SyncNodeValue{
+					"node2": {Version: 1, Buckets: map[int64]int64{idx: 5}},
+				},
+			},
+		},
+	})
+
+	v, err = r.Get("D")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), v)
+}
+
+func TestNodeSyncWindow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	v := newWindowVariable("A", int64(time.Minute), int64(time.Second))
+	v.updateWindow(10)
+
+	self := v.selfPart.syncValue()
+
+	mockClient := NewMockReplicatorClient(ctrl)
+
+	mockClient.EXPECT().Sync(
+		gomock.Any(),
+		&SyncRequest{
+			NodeID: "localNodeID",
+			Variables: map[string]*SyncVariable{
+				"A": {
+					Kind:   Kind_Window,
+					Window: &SyncWindow{Size: int64(time.Minute), BucketSize: int64(time.Second)},
+					NodesValues: map[string]*SyncNodeValue{
+						"localNodeID": self,
+					},
+				},
+			},
+		},
+	).Return(&SyncResponse{Code: 0}, nil)
+
+	node1 := &node{
+		logger:             zap.NewNop(),
+		connected:          1,
+		localNodeID:        "localNodeID",
+		replicatorClient:   mockClient,
+		buffer:             map[string]*variable{"A": v},
+		replicatedVersions: map[string]int64{},
+		metrics:            newMetrics(),
+	}
+
+	require.NoError(t, node1.sendSyncRequest())
+	assert.Equal(t, self.Version, node1.replicatedVersions["A@localNodeID"])
+}
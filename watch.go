@@ -0,0 +1,175 @@
+package rplx
+
+import (
+	"strings"
+	"sync"
+)
+
+var (
+	defaultWatchBufferSize = 1024
+)
+
+// EventType describe type of variable event
+type EventType int
+
+const (
+	// EventUpsert fires on local Upsert call
+	EventUpsert EventType = iota
+	// EventRemoteUpdate fires when variable part of remote node is applied by replication
+	EventRemoteUpdate
+	// EventTTL fires when variable TTL changed
+	EventTTL
+	// EventDelete fires when variable deleted
+	EventDelete
+	// EventExpire fires when expired variable removed
+	EventExpire
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventUpsert:
+		return "upsert"
+	case EventRemoteUpdate:
+		return "remote update"
+	case EventTTL:
+		return "ttl"
+	case EventDelete:
+		return "delete"
+	case EventExpire:
+		return "expire"
+	}
+
+	return "unknown"
+}
+
+// Event describe variable change, sends to Watch subscribers
+type Event struct {
+	Type EventType
+	Name string
+	// Value is variable value after change, 1 or 0 for enabled or disabled flag
+	Value int64
+	// TTL is variable TTL after change, in unix nano
+	TTL int64
+	// NodeID is ID of the node, where change was made
+	NodeID string
+	// Replicated is true if event caused by replication from remote node, false - if by local call
+	Replicated bool
+}
+
+// watcher describe Watch subscriber
+type watcher struct {
+	prefix string
+	ch     chan Event
+}
+
+// Watch subscribes to events of variables with provided name prefix, empty prefix means all variables
+// returns events channel and cancel function, cancel closes the channel
+// channel has bounded buffer (see WithWatchBufferSize option), if subscriber is slow and buffer is full, event is dropped
+func (rplx *Rplx) Watch(prefix string) (<-chan Event, func()) {
+	w := &watcher{
+		prefix: prefix,
+		ch:     make(chan Event, rplx.watchBufferSize),
+	}
+
+	rplx.watchersMx.Lock()
+	rplx.watchersSeq++
+	id := rplx.watchersSeq
+	rplx.watchers[id] = w
+	rplx.watchersMx.Unlock()
+
+	var once sync.Once
+
+	cancel := func() {
+		once.Do(func() {
+			rplx.watchersMx.Lock()
+			if _, ok := rplx.watchers[id]; ok {
+				delete(rplx.watchers, id)
+				close(w.ch)
+			}
+			rplx.watchersMx.Unlock()
+		})
+	}
+
+	return w.ch, cancel
+}
+
+// hasWatchers returns true, if exists at least one subscriber for variable name
+func (rplx *Rplx) hasWatchers(name string) bool {
+	rplx.watchersMx.RLock()
+	defer rplx.watchersMx.RUnlock()
+
+	for _, w := range rplx.watchers {
+		if strings.HasPrefix(name, w.prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// localEvent returns event for variable, changed by local call
+func (rplx *Rplx) localEvent(t EventType, v *variable) Event {
+	return Event{
+		Type:   t,
+		Name:   v.name,
+		Value:  rplx.eventValue(v),
+		TTL:    v.TTL(),
+		NodeID: rplx.nodeID,
+	}
+}
+
+// remoteEvent returns event for variable, changed by replication from node nodeID
+func (rplx *Rplx) remoteEvent(t EventType, v *variable, nodeID string) Event {
+	return Event{
+		Type:       t,
+		Name:       v.name,
+		Value:      rplx.eventValue(v),
+		TTL:        v.TTL(),
+		NodeID:     nodeID,
+		Replicated: true,
+	}
+}
+
+// eventValue returns variable value for event, flag value is 1 for enabled flag and 0 for disabled
+func (rplx *Rplx) eventValue(v *variable) int64 {
+	if v.flag() {
+		if v.flagEnabled(rplx.nodeID) {
+			return 1
+		}
+		return 0
+	}
+
+	return v.sum()
+}
+
+// notify sends events to subscribers without blocking
+func (rplx *Rplx) notify(events ...Event) {
+	rplx.watchersMx.RLock()
+	defer rplx.watchersMx.RUnlock()
+
+	for _, e := range events {
+		for _, w := range rplx.watchers {
+			if !strings.HasPrefix(e.Name, w.prefix) {
+				continue
+			}
+
+			select {
+			case w.ch <- e:
+			default:
+				if rplx.metrics != nil {
+					rplx.metrics.watchEventsDropped.WithLabelValues(e.Type.String()).Inc()
+				}
+			}
+		}
+	}
+}
+
+// stopWatchers closes all subscribers channels
+func (rplx *Rplx) stopWatchers() {
+	rplx.watchersMx.Lock()
+	for id, w := range rplx.watchers {
+		close(w.ch)
+		delete(rplx.watchers, id)
+	}
+	rplx.watchersMx.Unlock()
+}
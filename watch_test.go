@@ -0,0 +1,130 @@
+package rplx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func receiveEvent(t *testing.T, ch <-chan Event) Event {
+	t.Helper()
+
+	select {
+	case e, ok := <-ch:
+		require.True(t, ok, "channel closed")
+		return e
+	case <-time.After(time.Second):
+		require.FailNow(t, "event not received")
+	}
+
+	return Event{}
+}
+
+func TestWatch_Upsert(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	ch, cancel := r.Watch("A-")
+	defer cancel()
+
+	r.Upsert("B-1", 10)
+	r.Upsert("A-1", 20)
+
+	e := receiveEvent(t, ch)
+	assert.Equal(t, EventUpsert, e.Type)
+	assert.Equal(t, "A-1", e.Name)
+	assert.Equal(t, int64(20), e.Value)
+	assert.Equal(t, "node1", e.NodeID)
+	assert.False(t, e.Replicated)
+
+	assert.Len(t, ch, 0)
+}
+
+func TestWatch_TTLAndDelete(t *testing.T) {
+	r := New()
+
+	ch, cancel := r.Watch("")
+	defer cancel()
+
+	r.Upsert("A", 1)
+	ttl := time.Now().UTC().Add(time.Hour)
+	require.NoError(t, r.UpdateTTL("A", ttl))
+	require.NoError(t, r.Delete("A"))
+
+	assert.Equal(t, EventUpsert, receiveEvent(t, ch).Type)
+
+	e := receiveEvent(t, ch)
+	assert.Equal(t, EventTTL, e.Type)
+	assert.Equal(t, ttl.UnixNano(), e.TTL)
+
+	e = receiveEvent(t, ch)
+	assert.Equal(t, EventDelete, e.Type)
+	assert.Equal(t, int64(1), e.Value)
+}
+
+func TestWatch_Expire(t *testing.T) {
+	r := New()
+
+	ch, cancel := r.Watch("")
+	defer cancel()
+
+	r.Upsert("A", 1)
+	require.NoError(t, r.UpdateTTL("A", time.Now().UTC().Add(-time.Second)))
+	r.gc()
+
+	assert.Equal(t, EventUpsert, receiveEvent(t, ch).Type)
+	assert.Equal(t, EventTTL, receiveEvent(t, ch).Type)
+	assert.Equal(t, EventExpire, receiveEvent(t, ch).Type)
+}
+
+func TestWatch_RemoteUpdate(t *testing.T) {
+	r := New(WithNodeID("node1"))
+
+	ch, cancel := r.Watch("")
+	defer cancel()
+
+	r.sync(&SyncRequest{
+		NodeID: "node2",
+		Variables: map[string]*SyncVariable{
+			"A": {
+				NodesValues: map[string]*SyncNodeValue{
+					"node3": {Value: 300, Version: 1},
+				},
+			},
+		},
+	})
+
+	e := receiveEvent(t, ch)
+	assert.Equal(t, EventRemoteUpdate, e.Type)
+	assert.Equal(t, "A", e.Name)
+	assert.Equal(t, int64(300), e.Value)
+	assert.Equal(t, "node3", e.NodeID)
+	assert.True(t, e.Replicated)
+}
+
+func TestWatch_SlowSubscriber(t *testing.T) {
+	r := New(WithWatchBufferSize(2))
+
+	ch, cancel := r.Watch("")
+	defer cancel()
+
+	r.Upsert("A", 1)
+	r.Upsert("A", 1)
+	r.Upsert("A", 1)
+
+	assert.Len(t, ch, 2)
+}
+
+func TestWatch_Cancel(t *testing.T) {
+	r := New()
+
+	ch, cancel := r.Watch("")
+	cancel()
+	cancel()
+
+	r.Upsert("A", 1)
+
+	_, ok := <-ch
+	assert.False(t, ok)
+	assert.Len(t, r.watchers, 0)
+}